@@ -551,7 +551,7 @@ func (ctx *BDDContext) iRequestCurrentTimeInAlfredFormat() error {
 		return json.Unmarshal(errorOutput, &ctx.outputJSON)
 	}
 
-	alfredOutput, err := alfredFormatter.FormatTimeInfo(tz)
+	alfredOutput, err := alfredFormatter.FormatTimeInfo(tz, time.Now())
 	if err != nil {
 		return err
 	}
@@ -11,17 +11,35 @@ import (
 	"github.com/loginx/alfred-timein/internal/adapters/geocoder"
 	"github.com/loginx/alfred-timein/internal/adapters/presenter"
 	"github.com/loginx/alfred-timein/internal/adapters/timezonefinder"
+	"github.com/loginx/alfred-timein/internal/adapters/tzdata"
 	"github.com/loginx/alfred-timein/internal/domain"
 	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 func main() {
 	format := flag.String("format", "plain", "Output format: plain or alfred")
+	coords := flag.Bool("coords", false, "Follow the timezone with the place's latitude,longitude, for piping into timein")
+	tzdataFlag := flag.String("tzdata", os.Getenv(tzdata.EnvVar), "Where zone rules come from: auto (host, falling back to embedded), host or embedded (default auto, or $"+tzdata.EnvVar+")")
+	choose := flag.String("choose", "", "Remember this timezone, optionally followed by the chosen place's latitude,longitude, as the answer for the query")
+	geocoderFlag := flag.String("geocoder", os.Getenv(geocoder.EnvVar), "Geocoders to try in order, each optionally with a timeout and a fallback rule (any or unavailable): offline (embedded cities) and online (OpenStreetMap), e.g. online:3s:unavailable (default "+geocoder.DefaultChain+", or $"+geocoder.EnvVar+")")
 	flag.Usage = func() {
-		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [--format=plain|alfred] <city or landmark>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [--format=plain|alfred] [--coords] [--tzdata=auto|host|embedded] [--geocoder=offline,online] <city or landmark>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] --choose=<IANA Timezone>[ <lat>,<lng>] <city or landmark>\n", os.Args[0])
 	}
 	flag.Parse()
 
+	mode, err := tzdata.ParseMode(*tzdataFlag)
+	if err != nil {
+		outputError(err.Error(), *format)
+		os.Exit(1)
+	}
+	domain.SetLocationLoader(tzdata.Loader(mode))
+	geocoderAdapter, err := geocoder.ParseChain(*geocoderFlag, os.Getenv)
+	if err != nil {
+		outputError(err.Error(), *format)
+		os.Exit(1)
+	}
+
 	if flag.NArg() < 1 {
 		outputError("City or landmark argument required.", *format)
 		os.Exit(1)
@@ -36,18 +54,36 @@ func main() {
 	// Fast path: Check cache first before initializing expensive dependencies
 	// Always use geotz_cache.json in current directory
 	cacheAdapter := cache.NewLRUCache(1000, 30*24*time.Hour, ".")
-	cacheKey := strings.ToLower(city)
-	if tz, ok := cacheAdapter.Get(cacheKey); ok {
+	usecases.CanonicalizeCache(cacheAdapter)
+
+	// A pick among the candidates for an ambiguous query is remembered for that query
+	if *choose != "" {
+		geotzUC := usecases.NewGeotzUseCase(geocoderAdapter, timezonefinder.NewLazyTzfTimezoneFinder(), cacheAdapter, newFormatter(*format, *coords))
+		output, err := geotzUC.ChooseTimezone(city, *choose)
+		if err != nil {
+			outputError(err.Error(), *format)
+			os.Exit(1)
+		}
+		os.Stdout.Write(output)
+		return
+	}
+
+	// Candidates cached for an ambiguous query are formatted by the use case below
+	cacheKey := usecases.CacheKey(city)
+	if cached, ok := cacheAdapter.Get(cacheKey); ok && len(usecases.CachedZones(cached)) == 1 {
 		// Cache hit - skip expensive validation, just format and output
+		tz, place := domain.SplitCoordinates(cached)
 		if *format == "alfred" {
 			formatter := presenter.NewAlfredFormatter()
-			timezone := &domain.Timezone{Name: tz}
+			timezone := &domain.Timezone{Name: tz, Place: place}
 			output, err := formatter.FormatTimezoneInfo(timezone, city, true)
 			if err != nil {
 				outputError(err.Error(), *format)
 				os.Exit(1)
 			}
 			os.Stdout.Write(output)
+		} else if *coords {
+			fmt.Println((&domain.Timezone{Name: tz, Place: place}).PlacedName())
 		} else {
 			// Plain format - just output timezone name directly for maximum speed
 			fmt.Println(tz)
@@ -56,20 +92,11 @@ func main() {
 	}
 
 	// Cache miss - initialize all dependencies and use full use case
-	var formatter usecases.OutputFormatter
-	if *format == "alfred" {
-		formatter = presenter.NewAlfredFormatter()
-	} else {
-		formatter = presenter.NewPlainFormatter()
-	}
+	formatter := newFormatter(*format, *coords)
 
-	geocoderAdapter := geocoder.NewOpenStreetMapGeocoder()
 	
-	tzFinder, err := timezonefinder.NewTzfTimezoneFinder()
-	if err != nil {
-		outputError("Failed to initialize timezone finder.", *format)
-		os.Exit(1)
-	}
+	// Load the timezone dataset only when geocoding is needed, not for UTC offsets
+	tzFinder := timezonefinder.NewLazyTzfTimezoneFinder()
 
 	// Create use case and execute
 	geotzUC := usecases.NewGeotzUseCase(geocoderAdapter, tzFinder, cacheAdapter, formatter)
@@ -88,6 +115,15 @@ func main() {
 	os.Stdout.Write(output)
 }
 
+// newFormatter returns the presenter for a --format, following plain timezones with
+// coordinates when coords is set
+func newFormatter(format string, coords bool) usecases.OutputFormatter {
+	if format == "alfred" {
+		return presenter.NewAlfredFormatter()
+	}
+	return presenter.NewPlainFormatter().WithCoordinates(coords)
+}
+
 func outputError(msg string, format string) {
 	var formatter usecases.OutputFormatter
 	if format == "alfred" {
@@ -119,3 +119,37 @@ func TestGeotz_InvalidCity_Plain(t *testing.T) {
 		t.Errorf("expected error message in stderr, got: %v", result)
 	}
 }
+
+func TestGeotz_UTCOffset_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", "./main.go", "--format=plain", "UTC+5:30")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := strings.TrimSpace(string(out)); result != "UTC+05:30" {
+		t.Errorf("expected UTC+05:30, got: %v", result)
+	}
+}
+
+func TestGeotz_NegativeCoordinatesAfterDoubleDash_Plain(t *testing.T) {
+	// A leading minus would be read as a flag without the -- the workflow passes
+	cmd := exec.Command("go", "run", "./main.go", "--coords", "--", "-33.86,151.21")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := strings.TrimSpace(string(out)); result != "Australia/Sydney -33.860000,151.210000" {
+		t.Errorf("expected Australia/Sydney with its coordinates, got: %v", result)
+	}
+}
+
+func TestGeotz_CoordinatesOutOfRange_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", "./main.go", "--format=plain", "--", "91,0")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("expected error exit code for coordinates out of range")
+	}
+	if result := string(out); !strings.Contains(result, "coordinates out of range: 91,0") {
+		t.Errorf("expected a range error in stderr, got: %v", result)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/loginx/alfred-timein/internal/adapters/cache"
 	"github.com/loginx/alfred-timein/internal/adapters/timezonefinder"
+	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 type Capital struct {
@@ -62,8 +63,9 @@ func main() {
 		fmt.Printf("  %s, %s -> %s\n", capital.Name, capital.Country, timezone)
 	}
 
-	// Pre-seed the cache
+	// Pre-seed the cache, moving any deprecated zone names to their current ones
 	c.PreSeed(entries)
+	usecases.CanonicalizeCache(c)
 
 	fmt.Printf("Successfully pre-seeded cache with %d cities in %s\n", len(entries), filepath.Join(cacheDir, "geotz_cache.json"))
 }
\ No newline at end of file
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runConvert handles `timein convert <time> <place> in <place>`
+func runConvert(args []string, format string) {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		outputError("Conversion query required, e.g. \"3pm London in Tokyo\".", format)
+		os.Exit(1)
+	}
+
+	formatter := newFormatter(format)
+	convertUC := usecases.NewConvertUseCase(newTimezoneResolver(formatter), formatter).WithClock(clock)
+	output, err := convertUC.Convert(query)
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
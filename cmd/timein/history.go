@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runHistory handles `timein history [--from=DATE] [--to=DATE] [--at=DATE] <zone>`
+func runHistory(args []string, format string) {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain or alfred")
+	from := fs.String("from", "", "Start of the range: YYYY, YYYY-MM or YYYY-MM-DD (default: the zone's earliest record)")
+	to := fs.String("to", "", "End of the range, inclusive: YYYY, YYYY-MM or YYYY-MM-DD (default: a year from now)")
+	at := fs.String("at", "", "Report only the offset in effect at this date or instant, e.g. 2013-06-01")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s history [--from=DATE] [--to=DATE] [--at=DATE] <IANA Timezone or place>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	query := strings.TrimSpace(strings.Join(fs.Args(), " "))
+	if query == "" {
+		outputError("IANA timezone argument required.", format)
+		os.Exit(1)
+	}
+
+	formatter := newFormatter(format)
+	historyUC := usecases.NewHistoryUseCase(newTimezoneResolver(formatter), formatter).WithClock(clock)
+	output, err := historyUC.GetHistory(usecases.HistoryRequest{Zone: query, From: *from, To: *to, At: *at})
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
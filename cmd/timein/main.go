@@ -2,53 +2,152 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/loginx/alfred-timein/internal/adapters/geocoder"
+	"github.com/loginx/alfred-timein/internal/adapters/homezone"
 	"github.com/loginx/alfred-timein/internal/adapters/presenter"
+	"github.com/loginx/alfred-timein/internal/adapters/tzdata"
+	"github.com/loginx/alfred-timein/internal/domain"
 	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 func main() {
 	format := flag.String("format", "plain", "Output format: plain or alfred")
+	homeFlag := flag.String("home", "", "Home timezone for relative offsets (default: $"+homezone.EnvVar+", $TZ or /etc/localtime)")
+	workFlag := flag.String("work-hours", os.Getenv(workHoursEnvVar), "Working hours for the day-period hint (default 09:00-17:00, or $"+workHoursEnvVar+")")
+	sleepFlag := flag.String("sleep-hours", os.Getenv(sleepHoursEnvVar), "Sleeping hours for the day-period hint (default 23:00-07:00, or $"+sleepHoursEnvVar+")")
+	tzdataFlag := flag.String("tzdata", os.Getenv(tzdata.EnvVar), "Where zone rules come from: auto (host, falling back to embedded), host or embedded (default auto, or $"+tzdata.EnvVar+")")
+	geocoderFlag := flag.String("geocoder", os.Getenv(geocoder.EnvVar), "Geocoders to try in order, each optionally with a timeout and a fallback rule (any or unavailable): offline (embedded cities) and online (OpenStreetMap), e.g. online:3s:unavailable (default "+geocoder.DefaultChain+", or $"+geocoder.EnvVar+")")
+	versionFlag := flag.Bool("version", false, "Print the tzdata and tzf dataset versions and exit")
+	queryFlag := flag.String("query", "", "The geotz query the piped zones are candidates for; Alfred items then let the workflow remember the one picked with geotz --choose")
+	atFlag := flag.String("at", "", "Show times at this instant instead of now, e.g. 2026-03-29T01:30:00Z, \"2026-03-29 02:30 Europe/London\" or \"tomorrow 9am\"")
 	flag.Usage = func() {
-		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [--format=plain|alfred] <IANA Timezone>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [--format=plain|alfred] [--home=<IANA Timezone>] [--at=<instant>] <IANA Timezone>...\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       geotz --coords <city> | %s [--format=plain|alfred] [--query=<city>]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] convert <time> <place> in <place>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|json|alfred] overlap [flags] <place>[@09:00-17:00]...\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] transitions [--year=YYYY] <IANA Timezone>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] history [--from=DATE] [--to=DATE] [--at=DATE] <IANA Timezone>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] parse [--in=<place>,...] <timestamp>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] tzdiff [<IANA Timezone>...]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] search [--limit=N] <partial zone or city>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|json|alfred] zones [--offset=+05:30] [--region=Europe/] [--dst[=false]] [--at=<instant>]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] until <time> [<place>]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s [--format=plain|alfred] --version\n", os.Args[0])
 	}
 	flag.Parse()
+	candidatesFor = *queryFlag
 
-	var tz string
-	if flag.NArg() == 1 {
-		tz = flag.Arg(0)
-	} else if flag.NArg() == 0 {
-		// Try to read from STDIN
-		scanner := bufio.NewScanner(os.Stdin)
-		if scanner.Scan() {
-			tz = strings.TrimSpace(scanner.Text())
+	var err error
+	if tzdataMode, err = tzdata.ParseMode(*tzdataFlag); err != nil {
+		outputError(err.Error(), *format)
+		os.Exit(1)
+	}
+	domain.SetLocationLoader(tzdata.Loader(tzdataMode))
+	if geocoderChain, err = geocoder.ParseChain(*geocoderFlag, os.Getenv); err != nil {
+		outputError(err.Error(), *format)
+		os.Exit(1)
+	}
+
+	if home, err = homezone.NewSystemDetector().Detect(*homeFlag); err != nil {
+		outputError("Invalid home timezone: "+*homeFlag, *format)
+		os.Exit(1)
+	}
+	if schedule, err = parseSchedule(*workFlag, *sleepFlag); err != nil {
+		outputError(err.Error(), *format)
+		os.Exit(1)
+	}
+	if *atFlag != "" {
+		at, err := usecases.ParseInstant(*atFlag, time.Now(), newTimezoneResolver(newFormatter(*format)), home)
+		if err != nil {
+			outputError(err.Error(), *format)
+			os.Exit(1)
+		}
+		clock = usecases.FixedClock(at)
+	}
+
+	if *versionFlag {
+		runVersion(*format)
+		return
+	}
+
+	if flag.NArg() > 0 {
+		switch flag.Arg(0) {
+		case "convert":
+			runConvert(flag.Args()[1:], *format)
+			return
+		case "overlap":
+			runOverlap(flag.Args()[1:], *format)
+			return
+		case "transitions":
+			runTransitions(flag.Args()[1:], *format)
+			return
+		case "history":
+			runHistory(flag.Args()[1:], *format)
+			return
+		case "parse":
+			runParse(flag.Args()[1:], *format)
+			return
+		case "tzdiff":
+			runTzdiff(flag.Args()[1:], *format)
+			return
+		case "search":
+			runSearch(flag.Args()[1:], *format)
+			return
+		case "zones":
+			runZones(flag.Args()[1:], *format)
+			return
+		case "until":
+			runUntil(flag.Args()[1:], *format)
+			return
 		}
+	}
+
+	var zones []string
+	if flag.NArg() > 0 {
+		zones = flag.Args()
 	} else {
-		outputError("IANA timezone argument required.", *format)
-		os.Exit(1)
+		// Try to read from STDIN, one timezone per line
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			zones = append(zones, scanner.Text())
+		}
 	}
 
-	tz = strings.TrimSpace(tz)
-	if tz == "" {
+	zones = nonEmpty(zones)
+	if len(zones) == 0 {
 		outputError("IANA timezone argument required.", *format)
 		os.Exit(1)
 	}
 
 	// Create formatter based on output format
-	var formatter usecases.OutputFormatter
-	if *format == "alfred" {
-		formatter = presenter.NewAlfredFormatter()
-	} else {
-		formatter = presenter.NewPlainFormatter()
-	}
+	formatter := newFormatter(*format)
 
 	// Create use case and execute
-	timeinUC := usecases.NewTimeinUseCase(formatter)
-	output, err := timeinUC.GetTimezoneInfo(tz)
+	var output []byte
+	if len(zones) == 1 {
+		timeinUC := usecases.NewTimeinUseCase(formatter).WithClock(clock)
+		output, err = timeinUC.GetTimezoneInfo(zones[0])
+	} else {
+		worldClockUC := usecases.NewWorldClockUseCase(formatter).WithClock(clock)
+		output, err = worldClockUC.GetWorldClock(zones)
+	}
+	var suggested *usecases.SuggestedZonesError
+	if errors.As(err, &suggested) {
+		// Alfred lists the suggestions as results; scripts see the zone was not found
+		os.Stdout.Write(output)
+		if *format == "alfred" {
+			return
+		}
+		fmt.Fprintln(os.Stderr, "Error:", err.Error())
+		os.Exit(1)
+	}
 	if err != nil {
 		outputError(err.Error(), *format)
 		os.Exit(1)
@@ -57,15 +156,84 @@ func main() {
 	os.Stdout.Write(output)
 }
 
-func outputError(msg, format string) {
-	var formatter usecases.OutputFormatter
+// home is the user's home timezone, used to show times relative to it
+var home *domain.Timezone
+
+// tzdataMode records where zone rules come from; --tzdata sets it
+var tzdataMode = tzdata.ModeAuto
+
+// geocoderChain geocodes the places commands look up; --geocoder configures it
+var geocoderChain usecases.Geocoder
+
+// clock supplies the instant every command treats as now; --at fixes it
+var clock usecases.Clock = usecases.SystemClock{}
+
+// candidatesFor is the geotz query piped zones are candidates for; --query sets it
+var candidatesFor string
+
+// Workflow variables that configure the schedule behind the day-period hint
+const (
+	workHoursEnvVar  = "TIMEIN_WORK_HOURS"
+	sleepHoursEnvVar = "TIMEIN_SLEEP_HOURS"
+)
+
+// schedule holds the working and sleeping hours used to say what people are probably doing
+var schedule = domain.DefaultSchedule
+
+// parseSchedule overrides the default schedule's non-empty windows
+func parseSchedule(work, sleep string) (domain.Schedule, error) {
+	s := domain.DefaultSchedule
+	var err error
+	if strings.TrimSpace(work) != "" {
+		if s.Work, err = domain.ParseWorkingHours(work); err != nil {
+			return s, err
+		}
+	}
+	if strings.TrimSpace(sleep) != "" {
+		if s.Sleep, err = domain.ParseWorkingHours(sleep); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+// formatter is implemented by every presenter timein can output through
+type formatter interface {
+	usecases.OutputFormatter
+	usecases.ConversionFormatter
+	usecases.WorldClockFormatter
+	usecases.OverlapFormatter
+	usecases.TransitionFormatter
+	usecases.HistoryFormatter
+	usecases.ParseFormatter
+	usecases.TzdataFormatter
+	usecases.ZoneSearchFormatter
+	usecases.ZoneListFormatter
+	usecases.CountdownFormatter
+}
+
+func newFormatter(format string) formatter {
 	if format == "alfred" {
-		formatter = presenter.NewAlfredFormatter()
-	} else {
-		formatter = presenter.NewPlainFormatter()
+		_, fixed := clock.(usecases.FixedClock)
+		return presenter.NewAlfredFormatter().WithHomeTimezone(home).WithSchedule(schedule).WithFixedTime(fixed).
+			WithCandidatesFor(candidatesFor)
 	}
-	
-	output, err := formatter.FormatError(msg)
+	return presenter.NewPlainFormatter().WithHomeTimezone(home).WithSchedule(schedule)
+}
+
+// nonEmpty trims values and drops blank ones
+func nonEmpty(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func outputError(msg, format string) {
+	output, err := newFormatter(format).FormatError(msg)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Error formatting error message:", err)
 		return
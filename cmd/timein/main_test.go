@@ -9,7 +9,7 @@ import (
 )
 
 func TestTimein_Argv_Alfred(t *testing.T) {
-	cmd := exec.Command("go", "run", "./main.go", "--format=alfred", "America/New_York")
+	cmd := exec.Command("go", "run", ".", "--format=alfred", "America/New_York")
 	out, err := cmd.Output()
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -29,7 +29,7 @@ func TestTimein_Argv_Alfred(t *testing.T) {
 }
 
 func TestTimein_Argv_Plain(t *testing.T) {
-	cmd := exec.Command("go", "run", "./main.go", "--format=plain", "America/New_York")
+	cmd := exec.Command("go", "run", ".", "--format=plain", "America/New_York")
 	out, err := cmd.Output()
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -41,7 +41,7 @@ func TestTimein_Argv_Plain(t *testing.T) {
 }
 
 func TestTimein_Stdin_Alfred(t *testing.T) {
-	cmd := exec.Command("go", "run", "./main.go", "--format=alfred")
+	cmd := exec.Command("go", "run", ".", "--format=alfred")
 	cmd.Stdin = strings.NewReader("Europe/London\n")
 	out, err := cmd.Output()
 	if err != nil {
@@ -62,7 +62,7 @@ func TestTimein_Stdin_Alfred(t *testing.T) {
 }
 
 func TestTimein_Stdin_Plain(t *testing.T) {
-	cmd := exec.Command("go", "run", "./main.go", "--format=plain")
+	cmd := exec.Command("go", "run", ".", "--format=plain")
 	cmd.Stdin = strings.NewReader("Europe/London\n")
 	out, err := cmd.Output()
 	if err != nil {
@@ -75,7 +75,7 @@ func TestTimein_Stdin_Plain(t *testing.T) {
 }
 
 func TestTimein_Error_Alfred(t *testing.T) {
-	cmd := exec.Command("go", "run", "./main.go", "--format=alfred")
+	cmd := exec.Command("go", "run", ".", "--format=alfred")
 	cmd.Stdin = strings.NewReader("\n")
 	out, _ := cmd.Output()
 	var parsed map[string]interface{}
@@ -93,7 +93,7 @@ func TestTimein_Error_Alfred(t *testing.T) {
 }
 
 func TestTimein_Error_Plain(t *testing.T) {
-	cmd := exec.Command("go", "run", "./main.go", "--format=plain")
+	cmd := exec.Command("go", "run", ".", "--format=plain")
 	cmd.Stdin = strings.NewReader("\n")
 	out, err := cmd.CombinedOutput()
 	if err == nil {
@@ -104,3 +104,198 @@ func TestTimein_Error_Plain(t *testing.T) {
 		t.Errorf("expected error message in stderr, got: %v", result)
 	}
 }
+
+func TestTimein_MisspelledZone_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=plain", "Europe/Pariss")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err == nil {
+		t.Fatalf("expected error exit code for a misspelled zone")
+	}
+	if !strings.Contains(string(out), "Europe/Paris") {
+		t.Errorf("expected Europe/Paris to be suggested, got: %v", string(out))
+	}
+	if !strings.Contains(stderr.String(), "invalid timezone: Europe/Pariss") {
+		t.Errorf("expected the error in stderr, got: %v", stderr.String())
+	}
+}
+
+func TestTimein_MisspelledZone_Alfred(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=alfred", "Europe/Pariss")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("expected suggestions to be Alfred's results, got: %v", err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	item := parsed["items"].([]interface{})[0].(map[string]interface{})
+	if item["title"] != "Europe/Paris" {
+		t.Errorf("expected Europe/Paris first, got %v", item["title"])
+	}
+}
+
+func TestTimein_Convert_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=plain", "convert", "3pm", "Europe/London", "in", "Asia/Tokyo")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := strings.TrimSpace(string(out))
+	if !strings.Contains(result, "3:00 PM") || !strings.Contains(result, "(Tokyo)") {
+		t.Errorf("expected converted time, got: %v", result)
+	}
+}
+
+func TestTimein_Convert_Alfred(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=alfred", "convert", "9:30", "tomorrow", "America/New_York", "to", "Europe/Berlin")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	item := parsed["items"].([]interface{})[0].(map[string]interface{})
+	if !strings.Contains(item["subtitle"].(string), "9:30 AM") {
+		t.Errorf("expected source time in subtitle, got: %v", item["subtitle"])
+	}
+}
+
+func TestTimein_WorldClock_Alfred(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=alfred", "Asia/Tokyo", "Europe/London", "America/New_York")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	items := parsed["items"].([]interface{})
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+}
+
+func TestTimein_AmbiguousAbbreviation_Alfred(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=alfred", "IST")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	items := parsed["items"].([]interface{})
+	if len(items) != 3 {
+		t.Fatalf("expected one item per candidate, got %d", len(items))
+	}
+	if uid := items[1].(map[string]interface{})["uid"]; uid != "Europe/Dublin" {
+		t.Errorf("expected Europe/Dublin second, got %v", uid)
+	}
+}
+
+func TestTimein_UTCOffsets_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=plain", "UTC+5:30", "GMT-3")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "UTC+05:30") || !strings.HasPrefix(lines[1], "UTC-03:00") {
+		t.Errorf("unexpected rows: %v", lines)
+	}
+}
+
+func TestTimein_WorldClock_StdinPlain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--format=plain")
+	cmd.Stdin = strings.NewReader("Asia/Tokyo\n\nEurope/London\n")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 rows, got %d: %v", len(lines), lines)
+	}
+	if !strings.HasPrefix(lines[0], "Tokyo") || !strings.Contains(lines[0], "UTC+09:00") {
+		t.Errorf("unexpected first row: %v", lines[0])
+	}
+}
+
+func TestTimein_Overlap_JSON(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "overlap", "--format=json", "--from=2026-06-10", "--days=2", "Europe/London", "America/New_York@08:00-16:00")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed struct {
+		Slots []map[string]interface{} `json:"slots"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if len(parsed.Slots) != 2 {
+		t.Fatalf("expected 2 slots, got %d", len(parsed.Slots))
+	}
+	if parsed.Slots[0]["start"] != "2026-06-10T12:00:00Z" {
+		t.Errorf("unexpected first slot start: %v", parsed.Slots[0]["start"])
+	}
+}
+
+func TestTimein_HomeTimezone_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--home=Asia/Kolkata", "Asia/Kathmandu")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(out), "(+0:15, same day)") {
+		t.Errorf("expected offset relative to home, got: %s", out)
+	}
+}
+
+func TestTimein_Transitions_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "transitions", "--year=2026", "Europe/London")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 transitions, got %d: %v", len(lines), lines)
+	}
+	if !strings.Contains(lines[0], "GMT → ") || !strings.HasSuffix(lines[1], "DST ends") {
+		t.Errorf("unexpected transitions: %v", lines)
+	}
+}
+
+func TestTimein_Parse_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--home=UTC", "parse", "--in=Asia/Tokyo", "1718035200000")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := string(out)
+	if !strings.HasPrefix(result, "Detected Unix milliseconds\n") {
+		t.Errorf("expected detected format, got: %s", result)
+	}
+	if !strings.Contains(result, "Tue 11 Jun 2024, 1:00:00 AM") || !strings.Contains(result, "2024-06-10T16:00:00Z") {
+		t.Errorf("expected Tokyo time and RFC 3339, got: %s", result)
+	}
+}
+
+func TestTimein_At_Plain(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "--home=UTC", "--at=2026-03-29 02:30 Europe/London", "Australia/Sydney")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "Sunday, 29 March 2026, 12:30:00 PM (+11h, same day) – weekend" {
+		t.Errorf("unexpected time at instant: %s", got)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/adapters/presenter"
+	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runOverlap handles `timein overlap [flags] <place>[@hh:mm-hh:mm]...`
+func runOverlap(args []string, format string) {
+	fs := flag.NewFlagSet("overlap", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain, json or alfred")
+	hours := fs.String("hours", "", "Default working hours for every participant, e.g. 09:00-17:00 (default: --work-hours)")
+	from := fs.String("from", "", "First day as YYYY-MM-DD (default today)")
+	to := fs.String("to", "", "Last day as YYYY-MM-DD (overrides --days)")
+	days := fs.Int("days", 5, "Number of days to search")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s overlap [--hours=09:00-17:00] [--from=YYYY-MM-DD] [--to=YYYY-MM-DD|--days=N] <place>[@09:00-17:00]...\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	defaultHours := schedule.Work
+	if *hours != "" {
+		h, err := domain.ParseWorkingHours(*hours)
+		if err != nil {
+			outputError(err.Error(), format)
+			os.Exit(1)
+		}
+		defaultHours = h
+	}
+
+	req := usecases.OverlapRequest{From: *from, To: *to, Days: *days}
+	for _, arg := range fs.Args() {
+		// Alfred passes a single query, so participants may also be comma-separated
+		for _, spec := range nonEmpty(strings.Split(arg, ",")) {
+			p, err := parseParticipant(spec, defaultHours)
+			if err != nil {
+				outputError(err.Error(), format)
+				os.Exit(1)
+			}
+			req.Participants = append(req.Participants, p)
+		}
+	}
+	if len(req.Participants) == 0 {
+		outputError("At least one city or timezone is required.", format)
+		os.Exit(1)
+	}
+
+	formatter := newOverlapFormatter(format)
+	overlapUC := usecases.NewOverlapUseCase(newTimezoneResolver(newFormatter(format)), formatter).WithClock(clock)
+	output, err := overlapUC.GetOverlap(req)
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
+
+// parseParticipant parses "Tokyo" or "Tokyo@10:00-18:00"
+func parseParticipant(spec string, defaultHours domain.WorkingHours) (usecases.ParticipantRequest, error) {
+	place, window, ok := strings.Cut(spec, "@")
+	hours := defaultHours
+	if ok {
+		h, err := domain.ParseWorkingHours(window)
+		if err != nil {
+			return usecases.ParticipantRequest{}, err
+		}
+		hours = h
+	}
+	return usecases.ParticipantRequest{Place: strings.TrimSpace(place), Hours: &hours}, nil
+}
+
+func newOverlapFormatter(format string) usecases.OverlapFormatter {
+	if format == "json" {
+		return presenter.NewJSONFormatter()
+	}
+	return newFormatter(format)
+}
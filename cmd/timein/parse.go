@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runParse handles `timein parse [--in=<place>,...] <timestamp>`
+func runParse(args []string, format string) {
+	fs := flag.NewFlagSet("parse", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain or alfred")
+	in := fs.String("in", "", "Comma-separated places or timezones to also show the instant in")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s parse [--in=<place>,...] <Unix, RFC 3339, RFC 1123, RFC 822 or log timestamp>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	input := strings.TrimSpace(strings.Join(fs.Args(), " "))
+	if input == "" {
+		outputError("Timestamp required, e.g. 1718035200 or 2024-06-10T16:00:00Z.", format)
+		os.Exit(1)
+	}
+
+	formatter := newFormatter(format)
+	parseUC := usecases.NewParseUseCase(newTimezoneResolver(formatter), formatter).WithHomeTimezone(home)
+	output, err := parseUC.Parse(input, nonEmpty(strings.Split(*in, ",")))
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/adapters/cache"
+	"github.com/loginx/alfred-timein/internal/adapters/timezonefinder"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// newTimezoneResolver builds the geotz pipeline (Cache → Geocoder → TimezoneFinder)
+// sharing geotz's cache file, loading the tzf dataset only on a cache miss;
+// places are geocoded by the --geocoder chain
+func newTimezoneResolver(formatter usecases.OutputFormatter) usecases.TimezoneResolver {
+	cacheAdapter := cache.NewLRUCache(1000, 30*24*time.Hour, ".")
+	usecases.CanonicalizeCache(cacheAdapter)
+	return usecases.NewGeotzUseCase(
+		geocoderChain,
+		timezonefinder.NewLazyTzfTimezoneFinder(),
+		cacheAdapter,
+		formatter,
+	)
+}
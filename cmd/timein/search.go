@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runSearch handles `timein search [--limit=N] <partial zone or city>`
+func runSearch(args []string, format string) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain or alfred")
+	limit := fs.Int("limit", 10, "Suggest at most this many zones (0 for every match)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s search [--limit=N] <partial zone or city>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	query := strings.TrimSpace(strings.Join(fs.Args(), " "))
+	if query == "" {
+		outputError("Search text required.", format)
+		os.Exit(1)
+	}
+
+	searchUC := usecases.NewZoneSearchUseCase(newFormatter(format)).WithClock(clock).WithLimit(*limit)
+	output, err := searchUC.GetZoneSuggestions(query)
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runTransitions handles `timein transitions [--year=YYYY] <zone>`
+func runTransitions(args []string, format string) {
+	fs := flag.NewFlagSet("transitions", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain or alfred")
+	year := fs.Int("year", 0, "List every transition in this year (default: previous and next)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s transitions [--year=YYYY] <IANA Timezone or place>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	query := strings.TrimSpace(strings.Join(fs.Args(), " "))
+	if query == "" {
+		outputError("IANA timezone argument required.", format)
+		os.Exit(1)
+	}
+
+	formatter := newFormatter(format)
+	transitionsUC := usecases.NewTransitionsUseCase(newTimezoneResolver(formatter), formatter).WithClock(clock)
+	output, err := transitionsUC.GetTransitions(query, *year)
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/adapters/cache"
+	"github.com/loginx/alfred-timein/internal/adapters/timezonefinder"
+	"github.com/loginx/alfred-timein/internal/adapters/tzdata"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runVersion handles `timein --version`
+func runVersion(format string) {
+	formatter := newFormatter(format)
+	tzdataUC := usecases.NewTzdataUseCase(tzdata.NewHostSource(), tzdata.NewEmbeddedSource(), formatter).
+		WithDataset(timezonefinder.NewLazyTzfTimezoneFinder())
+	output, err := tzdataUC.GetVersion(buildVersion(), string(tzdataMode))
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
+
+// runTzdiff handles `timein tzdiff [<zone>...]`, defaulting to the zones in geotz's cache
+func runTzdiff(args []string, format string) {
+	fs := flag.NewFlagSet("tzdiff", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain or alfred")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s tzdiff [<IANA Timezone>...]\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Compares host and embedded tzdata rules, by default for every zone in the cache")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	formatter := newFormatter(format)
+	tzdataUC := usecases.NewTzdataUseCase(tzdata.NewHostSource(), tzdata.NewEmbeddedSource(), formatter).
+		WithCache(cache.NewLRUCache(1000, 30*24*time.Hour, ".")).
+		WithClock(clock)
+	output, err := tzdataUC.GetDiff(nonEmpty(fs.Args()))
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
+
+// buildVersion returns the module version the binary was built from, if recorded
+func buildVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return ""
+}
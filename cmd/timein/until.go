@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runUntil handles `timein until <time> [<place>]`, e.g. `timein until Friday 17:00 London`
+func runUntil(args []string, format string) {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		outputError("Countdown query required, e.g. \"9am Tokyo\".", format)
+		os.Exit(1)
+	}
+
+	formatter := newFormatter(format)
+	countdownUC := usecases.NewCountdownUseCase(newTimezoneResolver(formatter), formatter).WithClock(clock).WithHomeTimezone(home)
+	output, err := countdownUC.GetCountdown(query)
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
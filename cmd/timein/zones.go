@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/adapters/presenter"
+	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// runZones handles `timein zones [--offset=+05:30] [--region=Europe/] [--dst] [<offset or region>...]`
+func runZones(args []string, format string) {
+	fs := flag.NewFlagSet("zones", flag.ExitOnError)
+	fs.StringVar(&format, "format", format, "Output format: plain, json or alfred")
+	offset := fs.String("offset", "", "Only zones at this UTC offset, e.g. +05:30 or UTC-3")
+	region := fs.String("region", "", "Only zones whose name starts with this prefix, e.g. Europe/")
+	dst := fs.Bool("dst", false, "Only zones that observe DST this year; --dst=false for those that do not")
+	at := fs.String("at", "", "Group zones by their offsets at this instant instead of now")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s zones [--offset=+05:30] [--region=Europe/] [--dst[=false]] [--at=<instant>] [<offset or region>...]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	req := usecases.ZoneListRequest{Offset: *offset, Region: *region}
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "dst" {
+			req.DST = dst
+		}
+	})
+	// Alfred passes a single query, so offsets and regions may also be given bare
+	for _, arg := range nonEmpty(fs.Args()) {
+		if _, ok := domain.ParseUTCOffset(arg); ok {
+			req.Offset = arg
+		} else {
+			req.Region = arg
+		}
+	}
+
+	zonesClock := clock
+	if *at != "" {
+		instant, err := usecases.ParseInstant(*at, time.Now(), newTimezoneResolver(newFormatter(format)), home)
+		if err != nil {
+			outputError(err.Error(), format)
+			os.Exit(1)
+		}
+		zonesClock = usecases.FixedClock(instant)
+	}
+
+	zonesUC := usecases.NewZoneListUseCase(newZoneListFormatter(format)).WithClock(zonesClock)
+	output, err := zonesUC.GetZoneList(req)
+	if err != nil {
+		outputError(err.Error(), format)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(output)
+}
+
+func newZoneListFormatter(format string) usecases.ZoneListFormatter {
+	if format == "json" {
+		return presenter.NewJSONFormatter()
+	}
+	return newFormatter(format)
+}
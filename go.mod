@@ -3,7 +3,6 @@ module github.com/loginx/alfred-timein
 go 1.24.3
 
 require (
-	github.com/codingsince1985/geo-golang v1.8.5
 	github.com/cucumber/godog v0.15.0
 	github.com/ringsaturn/tzf v1.0.0
 	github.com/tkuchiki/go-timezone v0.2.3
@@ -131,6 +131,65 @@ func (c *LRUCache) PreSeed(entries map[string]string) {
 	c.persistUnsafe()
 }
 
+// Values returns the unexpired values, most recently used first, without touching their order
+func (c *LRUCache) Values() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	values := make([]string, 0, len(c.order))
+	for _, k := range c.order {
+		entry, ok := c.entries[k]
+		if !ok {
+			continue
+		}
+		ttl := c.ttl
+		if entry.TTL > 0 {
+			ttl = entry.TTL
+		}
+		if time.Since(entry.CreatedAt) > ttl {
+			continue
+		}
+		values = append(values, entry.Value)
+	}
+	return values
+}
+
+// Rewrite passes every entry through fn and stores what it returns, keeping each entry's
+// age and TTL. Entries rewritten to the same key collapse into the most recently used
+// one. The file is saved only when something changed; Rewrite returns how many entries
+// were changed or dropped
+func (c *LRUCache) Rewrite(fn func(key, value string) (string, string)) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	changed := 0
+	entries := make(map[string]cacheEntry, len(c.entries))
+	order := make([]string, 0, len(c.order))
+	for _, k := range c.order {
+		entry, ok := c.entries[k]
+		if !ok {
+			continue
+		}
+		key, value := fn(k, entry.Value)
+		_, dup := entries[key]
+		if dup || key != k || value != entry.Value {
+			changed++
+		}
+		if dup {
+			continue
+		}
+		entry.Value = value
+		entries[key] = entry
+		order = append(order, key)
+	}
+
+	if changed > 0 {
+		c.entries, c.order = entries, order
+		c.persistUnsafe()
+	}
+	return changed
+}
+
 // Clear removes all entries from the cache
 func (c *LRUCache) Clear() {
 	c.mu.Lock()
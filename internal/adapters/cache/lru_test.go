@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -350,4 +351,53 @@ func TestLRUCache_EvictionRespectsTTL(t *testing.T) {
 	if value, ok := cache.Get("long-lived"); !ok || value != "special" {
 		t.Errorf("expected long-lived entry to be available")
 	}
-}
\ No newline at end of file
+}
+
+func TestLRUCache_Values(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewLRUCache(3, time.Hour, dir)
+	cache.Set("a", "1")
+	cache.Set("b", "2")
+	cache.SetWithTTL("c", "3", time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	values := cache.Values()
+	if len(values) != 2 || values[0] != "2" || values[1] != "1" {
+		t.Errorf("expected [2 1], got %v", values)
+	}
+}
+
+func TestLRUCache_Rewrite_ShouldCollapseDuplicateKeys(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewLRUCache(10, time.Hour, dir)
+	cache.Set("asia/kolkata", "Asia/Kolkata")
+	cache.Set("paris", "Europe/Paris")
+	cache.Set("asia/calcutta", "Asia/Calcutta")
+
+	changed := cache.Rewrite(func(key, value string) (string, string) {
+		if key == "asia/calcutta" {
+			key = "asia/kolkata"
+		}
+		return key, strings.Replace(value, "Calcutta", "Kolkata", 1)
+	})
+
+	// The renamed entry is kept, being the most recently used, and the older one dropped
+	if changed != 2 {
+		t.Errorf("expected 2 changes, got %d", changed)
+	}
+	if v, ok := cache.Get("asia/kolkata"); !ok || v != "Asia/Kolkata" {
+		t.Errorf("expected Asia/Kolkata, got %q", v)
+	}
+	if _, ok := cache.Get("asia/calcutta"); ok {
+		t.Error("expected the old key to be gone")
+	}
+
+	// The migration is saved
+	reloaded := NewLRUCache(10, time.Hour, dir)
+	if values := reloaded.Values(); len(values) != 2 {
+		t.Errorf("expected 2 entries after reload, got %v", values)
+	}
+	if changed := reloaded.Rewrite(func(key, value string) (string, string) { return key, value }); changed != 0 {
+		t.Errorf("expected nothing to change, got %d", changed)
+	}
+}
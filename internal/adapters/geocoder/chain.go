@@ -0,0 +1,161 @@
+package geocoder
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+var (
+	// ErrNoResults is wrapped by geocoders that answered but know no such place
+	ErrNoResults = errors.New("no results found")
+	// ErrTimeout is wrapped when a provider in a chain takes longer than its timeout
+	ErrTimeout = errors.New("geocoder timed out")
+)
+
+// FallbackRule decides whether a provider's error lets the chain try the next provider
+type FallbackRule func(err error) bool
+
+// FallbackOnAnyError moves on to the next provider whatever went wrong
+func FallbackOnAnyError(err error) bool {
+	return true
+}
+
+// FallbackOnUnavailable moves on only when the provider could not answer, such as on a
+// network error or timeout, and trusts it when it reports that no such place exists
+func FallbackOnUnavailable(err error) bool {
+	return !errors.Is(err, ErrNoResults)
+}
+
+// ConfidentGeocoder is implemented by geocoders that can tell a sure match from a guess,
+// such as a place whose name merely starts with the query
+type ConfidentGeocoder interface {
+	GeocodeConfidentCandidates(query string, limit int) ([]*domain.Location, bool, error)
+}
+
+// Provider is one geocoder in a ChainGeocoder
+type Provider struct {
+	Name     string // recorded as the Source of the locations it finds
+	Geocoder usecases.Geocoder
+	Timeout  time.Duration // 0 waits as long as the geocoder takes
+	Fallback FallbackRule  // nil means FallbackOnAnyError
+}
+
+// ChainGeocoder implements the Geocoder interface by trying providers in order until
+// one finds the place, recording which one answered as the location's Source
+type ChainGeocoder struct {
+	providers []Provider
+}
+
+// NewChainGeocoder creates a ChainGeocoder trying providers in the given order
+func NewChainGeocoder(providers ...Provider) *ChainGeocoder {
+	return &ChainGeocoder{providers: providers}
+}
+
+// Providers returns the providers in the order they are tried
+func (g *ChainGeocoder) Providers() []Provider {
+	return g.providers
+}
+
+// Geocode returns the best match of the first provider that finds the place
+func (g *ChainGeocoder) Geocode(query string) (*domain.Location, error) {
+	candidates, err := g.GeocodeCandidates(query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+// GeocodeCandidates asks each provider in turn and returns the candidates of the first
+// that finds the place. A provider's error ends the chain unless its fallback rule allows
+// trying the next one; when every provider says it knows no such place the error wraps
+// ErrNoResults. A ConfidentGeocoder's guesses are only returned if no later provider
+// finds the place
+func (g *ChainGeocoder) GeocodeCandidates(query string, limit int) ([]*domain.Location, error) {
+	if len(g.providers) == 0 {
+		return nil, fmt.Errorf("geocoding failed: no geocoders configured")
+	}
+
+	var failures []string
+	var guess []*domain.Location
+	allNoResults := true
+	for i, p := range g.providers {
+		candidates, confident, err := geocodeWithTimeout(p, query, limit)
+		if err == nil && len(candidates) == 0 {
+			err = fmt.Errorf("%w for: %s", ErrNoResults, query)
+		}
+		if err == nil {
+			for _, location := range candidates {
+				if location.Source == "" {
+					location.Source = p.Name
+				}
+			}
+			if confident || i == len(g.providers)-1 {
+				return candidates, nil
+			}
+			if guess == nil {
+				guess = candidates
+			}
+			continue
+		}
+		if guess != nil {
+			continue
+		}
+
+		failures = append(failures, p.Name+": "+err.Error())
+		allNoResults = allNoResults && errors.Is(err, ErrNoResults)
+		fallback := p.Fallback
+		if fallback == nil {
+			fallback = FallbackOnAnyError
+		}
+		if !fallback(err) {
+			return nil, fmt.Errorf("%s: %w", p.Name, err)
+		}
+	}
+
+	if guess != nil {
+		return guess, nil
+	}
+	if allNoResults {
+		return nil, fmt.Errorf("%w for: %s", ErrNoResults, query)
+	}
+	return nil, fmt.Errorf("geocoding failed: %s", strings.Join(failures, "; "))
+}
+
+// geocodeWithTimeout runs a provider, giving up after its timeout. Geocoders take no
+// context, so a provider that times out finishes in the background and is ignored. Only
+// ConfidentGeocoders may answer with a guess
+func geocodeWithTimeout(p Provider, query string, limit int) ([]*domain.Location, bool, error) {
+	geocode := func() ([]*domain.Location, bool, error) {
+		if cg, ok := p.Geocoder.(ConfidentGeocoder); ok {
+			return cg.GeocodeConfidentCandidates(query, limit)
+		}
+		candidates, err := p.Geocoder.GeocodeCandidates(query, limit)
+		return candidates, true, err
+	}
+	if p.Timeout <= 0 {
+		return geocode()
+	}
+
+	type result struct {
+		candidates []*domain.Location
+		confident  bool
+		err        error
+	}
+	done := make(chan result, 1)
+	go func() {
+		candidates, confident, err := geocode()
+		done <- result{candidates, confident, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.candidates, r.confident, r.err
+	case <-time.After(p.Timeout):
+		return nil, false, fmt.Errorf("%w after %s", ErrTimeout, p.Timeout)
+	}
+}
@@ -0,0 +1,161 @@
+package geocoder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// stubGeocoder answers every query with a fixed location or error, optionally slowly
+type stubGeocoder struct {
+	location *domain.Location
+	err      error
+	delay    time.Duration
+	calls    int
+}
+
+func (s *stubGeocoder) Geocode(query string) (*domain.Location, error) {
+	candidates, err := s.GeocodeCandidates(query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+func (s *stubGeocoder) GeocodeCandidates(query string, limit int) ([]*domain.Location, error) {
+	s.calls++
+	time.Sleep(s.delay)
+	if s.err != nil {
+		return nil, s.err
+	}
+	location := *s.location
+	return []*domain.Location{&location}, nil
+}
+
+func TestChainGeocoder_ShouldFallBackAndRecordTheProvider(t *testing.T) {
+	// Given a first provider that knows no such place and a second that does
+	first := &stubGeocoder{err: fmt.Errorf("%w for: x", ErrNoResults)}
+	second := &stubGeocoder{location: &domain.Location{Name: "Paris", Latitude: 48.85, Longitude: 2.35}}
+	chain := NewChainGeocoder(Provider{Name: "first", Geocoder: first}, Provider{Name: "second", Geocoder: second})
+
+	// When geocoding
+	location, err := chain.Geocode("Paris")
+
+	// Then the second provider should answer and be recorded
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if location.Name != "Paris" || location.Source != "second" {
+		t.Errorf("expected Paris via second, got %+v", location)
+	}
+}
+
+func TestChainGeocoder_ShouldStopAtTheFirstAnswer(t *testing.T) {
+	first := &stubGeocoder{location: &domain.Location{Name: "Paris"}}
+	second := &stubGeocoder{location: &domain.Location{Name: "Paris, TX"}}
+	chain := NewChainGeocoder(Provider{Name: "first", Geocoder: first}, Provider{Name: "second", Geocoder: second})
+
+	location, err := chain.Geocode("Paris")
+	if err != nil || location.Source != "first" {
+		t.Fatalf("expected an answer from first, got %+v, %v", location, err)
+	}
+	if second.calls != 0 {
+		t.Errorf("expected the second provider not to be asked, got %d calls", second.calls)
+	}
+}
+
+func TestChainGeocoder_ShouldTimeOutSlowProviders(t *testing.T) {
+	// Given a provider slower than its timeout
+	slow := &stubGeocoder{location: &domain.Location{Name: "Paris"}, delay: 200 * time.Millisecond}
+	fast := &stubGeocoder{location: &domain.Location{Name: "Paris"}}
+	chain := NewChainGeocoder(
+		Provider{Name: "slow", Geocoder: slow, Timeout: 10 * time.Millisecond},
+		Provider{Name: "fast", Geocoder: fast},
+	)
+
+	// When geocoding
+	location, err := chain.Geocode("Paris")
+
+	// Then the chain should move on to the next provider
+	if err != nil || location.Source != "fast" {
+		t.Errorf("expected an answer from fast, got %+v, %v", location, err)
+	}
+}
+
+func TestChainGeocoder_ShouldApplyEachProvidersFallbackRule(t *testing.T) {
+	next := &stubGeocoder{location: &domain.Location{Name: "Paris"}}
+
+	// Given a provider that is trusted when it knows no such place
+	trusted := &stubGeocoder{err: fmt.Errorf("%w for: Atlantis", ErrNoResults)}
+	chain := NewChainGeocoder(
+		Provider{Name: "trusted", Geocoder: trusted, Fallback: FallbackOnUnavailable},
+		Provider{Name: "next", Geocoder: next},
+	)
+
+	// Then its answer should end the chain
+	if _, err := chain.Geocode("Atlantis"); !errors.Is(err, ErrNoResults) || next.calls != 0 {
+		t.Errorf("expected the chain to stop with ErrNoResults, got %v after %d calls", err, next.calls)
+	}
+
+	// But a network failure from the same provider should fall back
+	trusted.err = errors.New("connection refused")
+	if location, err := chain.Geocode("Paris"); err != nil || location.Source != "next" {
+		t.Errorf("expected an answer from next, got %+v, %v", location, err)
+	}
+}
+
+func TestChainGeocoder_ShouldCheckOfflineGuessesOnline(t *testing.T) {
+	// Given the default order, with an online provider that knows Victoria, British Columbia
+	online := &stubGeocoder{location: &domain.Location{Name: "Victoria", Latitude: 48.4284, Longitude: -123.3656}}
+	chain := NewChainGeocoder(
+		Provider{Name: "offline", Geocoder: NewGazetteerGeocoder()},
+		Provider{Name: "online", Geocoder: online},
+	)
+
+	// When the gazetteer only knows a small namesake, or a city starting with the query
+	// Then the online provider should be asked and answer
+	for _, query := range []string{"Victoria", "Johannes"} {
+		location, err := chain.Geocode(query)
+		if err != nil || location.Source != "online" {
+			t.Errorf("for %q, expected an online answer, got %+v, %v", query, location, err)
+		}
+	}
+
+	// And a whole name of a large city should not reach it
+	online.calls = 0
+	if location, err := chain.Geocode("Paris"); err != nil || location.Source != "offline" || online.calls != 0 {
+		t.Errorf("expected Paris offline without asking online, got %+v, %v after %d calls", location, err, online.calls)
+	}
+
+	// But when the online provider cannot answer, the guess should stand
+	online.err = errors.New("connection refused")
+	if location, err := chain.Geocode("Johannes"); err != nil || location.Name != "Johannesburg" || location.Source != "offline" {
+		t.Errorf("expected Johannesburg offline, got %+v, %v", location, err)
+	}
+}
+
+func TestChainGeocoder_ShouldReportWhyEveryProviderFailed(t *testing.T) {
+	notFound := NewChainGeocoder(
+		Provider{Name: "a", Geocoder: &stubGeocoder{err: fmt.Errorf("%w for: x", ErrNoResults)}},
+		Provider{Name: "b", Geocoder: &stubGeocoder{err: fmt.Errorf("%w for: x", ErrNoResults)}},
+	)
+	if _, err := notFound.Geocode("x"); !errors.Is(err, ErrNoResults) {
+		t.Errorf("expected ErrNoResults when no provider knows the place, got %v", err)
+	}
+
+	failing := NewChainGeocoder(
+		Provider{Name: "a", Geocoder: &stubGeocoder{err: fmt.Errorf("%w for: x", ErrNoResults)}},
+		Provider{Name: "b", Geocoder: &stubGeocoder{err: errors.New("connection refused")}},
+	)
+	_, err := failing.Geocode("x")
+	if err == nil || errors.Is(err, ErrNoResults) || err.Error() != "geocoding failed: a: no results found for: x; b: connection refused" {
+		t.Errorf("expected each provider's failure, got %v", err)
+	}
+
+	if _, err := NewChainGeocoder().Geocode("x"); err == nil {
+		t.Errorf("expected error for an empty chain")
+	}
+}
@@ -0,0 +1,130 @@
+package geocoder
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// EnvVar names the workflow variable that configures the geocoder chain
+const EnvVar = "TIMEIN_GEOCODER"
+
+// Workflow variables that configure the online geocoder
+const (
+	NominatimURLEnvVar       = "TIMEIN_NOMINATIM_URL"        // base URL of a self-hosted instance
+	NominatimUserAgentEnvVar = "TIMEIN_NOMINATIM_USER_AGENT" // replaces DefaultUserAgent
+	NominatimEmailEnvVar     = "TIMEIN_NOMINATIM_EMAIL"      // contact address sent with requests
+	NominatimLanguageEnvVar  = "TIMEIN_NOMINATIM_LANGUAGE"   // Accept-Language, e.g. "de,en"
+	NominatimIntervalEnvVar  = "TIMEIN_NOMINATIM_INTERVAL"   // time between requests, e.g. 1s; 0 disables
+)
+
+// Mode names a provider a chain can be built from
+type Mode string
+
+const (
+	// ModeOnline asks OpenStreetMap's Nominatim service
+	ModeOnline Mode = "online"
+	// ModeOffline looks cities up in the embedded gazetteer, without the network
+	ModeOffline Mode = "offline"
+)
+
+// DefaultChain tries the embedded gazetteer first and asks Nominatim for anything else,
+// such as landmarks and addresses
+const DefaultChain = "offline,online"
+
+// defaultOnlineTimeout bounds a Nominatim request unless the chain says otherwise
+const defaultOnlineTimeout = 5 * time.Second
+
+// fallbackRules names the rules a chain entry can give for falling back to the next
+// provider. Every provider falls back on any error unless its entry says otherwise
+var fallbackRules = map[string]FallbackRule{
+	"any":         FallbackOnAnyError,
+	"unavailable": FallbackOnUnavailable,
+}
+
+// ParseChain builds a ChainGeocoder from a comma-separated list of providers, each
+// optionally followed by a timeout and a fallback rule, e.g. "offline,online:3s:unavailable";
+// empty means DefaultChain. The rule is "any" (the default) to try the next provider
+// whatever went wrong, or "unavailable" to try it only when the provider could not
+// answer, trusting it when it knows no such place. The online provider is configured
+// from getenv
+func ParseChain(spec string, getenv func(string) string) (*ChainGeocoder, error) {
+	if strings.TrimSpace(spec) == "" {
+		spec = DefaultChain
+	}
+
+	var providers []Provider
+	seen := make(map[Mode]bool)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		fields := strings.Split(entry, ":")
+		mode := Mode(strings.ToLower(strings.TrimSpace(fields[0])))
+		if seen[mode] {
+			return nil, fmt.Errorf("invalid geocoder chain: %s is listed twice", mode)
+		}
+		seen[mode] = true
+
+		var p Provider
+		switch mode {
+		case ModeOffline:
+			p = Provider{Name: string(mode), Geocoder: NewGazetteerGeocoder()}
+		case ModeOnline:
+			nominatim, err := NominatimFromEnv(getenv)
+			if err != nil {
+				return nil, err
+			}
+			p = Provider{Name: string(mode), Geocoder: nominatim, Timeout: defaultOnlineTimeout}
+		default:
+			return nil, fmt.Errorf("invalid geocoder: %s (want online or offline)", entry)
+		}
+		p.Fallback = FallbackOnAnyError
+
+		var hasTimeout, hasRule bool
+		for _, field := range fields[1:] {
+			field = strings.ToLower(strings.TrimSpace(field))
+			if rule, ok := fallbackRules[field]; ok && !hasRule {
+				p.Fallback, hasRule = rule, true
+				continue
+			}
+			timeout, err := time.ParseDuration(field)
+			if err != nil || timeout < 0 || hasTimeout {
+				return nil, fmt.Errorf("invalid geocoder option: %s (want a timeout such as 3s, any or unavailable)", entry)
+			}
+			p.Timeout, hasTimeout = timeout, true
+		}
+		providers = append(providers, p)
+	}
+	return NewChainGeocoder(providers...), nil
+}
+
+// NominatimFromEnv configures an OpenStreetMapGeocoder from the Nominatim workflow
+// variables, rate limited across processes through a state file per instance
+func NominatimFromEnv(getenv func(string) string) (*OpenStreetMapGeocoder, error) {
+	g := NewOpenStreetMapGeocoder()
+	if base := strings.TrimSpace(getenv(NominatimURLEnvVar)); base != "" {
+		u, err := url.Parse(base)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid %s: %s", NominatimURLEnvVar, base)
+		}
+		g.WithBaseURL(base)
+	}
+	if userAgent := strings.TrimSpace(getenv(NominatimUserAgentEnvVar)); userAgent != "" {
+		g.WithUserAgent(userAgent)
+	}
+	g.WithEmail(strings.TrimSpace(getenv(NominatimEmailEnvVar)))
+	g.WithAcceptLanguage(strings.TrimSpace(getenv(NominatimLanguageEnvVar)))
+
+	interval := DefaultNominatimInterval
+	if text := strings.TrimSpace(getenv(NominatimIntervalEnvVar)); text != "" {
+		var err error
+		if interval, err = time.ParseDuration(text); err != nil || interval < 0 {
+			return nil, fmt.Errorf("invalid %s: %s", NominatimIntervalEnvVar, text)
+		}
+	}
+	u, _ := url.Parse(g.baseURL)
+	state := filepath.Join(os.TempDir(), "alfred-timein-nominatim-"+strings.ReplaceAll(u.Host, ":", "_"))
+	return g.WithRateLimiter(NewFileRateLimiter(state, interval)), nil
+}
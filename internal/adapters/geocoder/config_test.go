@@ -0,0 +1,157 @@
+package geocoder
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// noEnv is a getenv with no workflow variables set
+func noEnv(string) string { return "" }
+
+func TestParseChain(t *testing.T) {
+	// Given no configuration
+	chain, err := ParseChain("", noEnv)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the gazetteer should be tried before Nominatim
+	providers := chain.Providers()
+	if len(providers) != 2 || providers[0].Name != "offline" || providers[1].Name != "online" {
+		t.Fatalf("expected offline then online, got %+v", providers)
+	}
+	if _, ok := providers[0].Geocoder.(*GazetteerGeocoder); !ok {
+		t.Errorf("expected offline to use the gazetteer")
+	}
+	if providers[1].Timeout != defaultOnlineTimeout {
+		t.Errorf("expected the default online timeout, got %s", providers[1].Timeout)
+	}
+
+	// And timeouts should be configurable per provider
+	chain, err = ParseChain(" Online:2s ", noEnv)
+	if err != nil || len(chain.Providers()) != 1 || chain.Providers()[0].Timeout != 2*time.Second {
+		t.Errorf("expected online with a 2s timeout, got %+v, %v", chain, err)
+	}
+
+	// And so should fallback rules, in either order after the name
+	chain, err = ParseChain("online:unavailable:2s", noEnv)
+	if err != nil || len(chain.Providers()) != 1 || chain.Providers()[0].Timeout != 2*time.Second {
+		t.Errorf("expected online with a 2s timeout, got %+v, %v", chain, err)
+	}
+
+	for _, spec := range []string{"carrier-pigeon", "online:soon", "offline,offline", "online:-1s", "online:1s:2s", "online:any:unavailable"} {
+		if _, err := ParseChain(spec, noEnv); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+}
+
+func TestNominatimFromEnv_ShouldApplyWorkflowVariables(t *testing.T) {
+	// Given a self-hosted instance and contact details
+	env := map[string]string{
+		NominatimURLEnvVar:       "https://nominatim.example.com/osm",
+		NominatimUserAgentEnvVar: "acme-oncall/2.0",
+		NominatimEmailEnvVar:     "ops@example.com",
+		NominatimLanguageEnvVar:  "de,en",
+		NominatimIntervalEnvVar:  "0",
+	}
+
+	// When configuring the online geocoder from them
+	g, err := NominatimFromEnv(func(name string) string { return env[name] })
+
+	// Then every setting should be applied
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.baseURL != "https://nominatim.example.com/osm/" || g.userAgent != "acme-oncall/2.0" ||
+		g.email != "ops@example.com" || g.acceptLanguage != "de,en" {
+		t.Errorf("unexpected configuration %+v", g)
+	}
+	if limiter, ok := g.limiter.(*FileRateLimiter); !ok || limiter.interval != 0 {
+		t.Errorf("expected a disabled rate limiter, got %+v", g.limiter)
+	}
+}
+
+func TestNominatimFromEnv_ShouldDefaultToThePublicInstance(t *testing.T) {
+	g, err := NominatimFromEnv(noEnv)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.baseURL != DefaultNominatimURL || g.userAgent != DefaultUserAgent {
+		t.Errorf("expected the public instance and default User-Agent, got %+v", g)
+	}
+	if limiter, ok := g.limiter.(*FileRateLimiter); !ok || limiter.interval != DefaultNominatimInterval {
+		t.Errorf("expected one request per second, got %+v", g.limiter)
+	}
+}
+
+func TestNominatimFromEnv_ShouldRejectInvalidSettings(t *testing.T) {
+	for name, value := range map[string]string{
+		NominatimURLEnvVar:      "nominatim.example.com",
+		NominatimIntervalEnvVar: "often",
+	} {
+		getenv := func(n string) string {
+			if n == name {
+				return value
+			}
+			return ""
+		}
+		if _, err := NominatimFromEnv(getenv); err == nil {
+			t.Errorf("expected error for %s=%s", name, value)
+		}
+	}
+}
+
+func TestParseChain_ShouldApplyEachFallbackRule(t *testing.T) {
+	// Given a Nominatim instance that knows no such place, and one that is down
+	empty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer empty.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "down for maintenance", http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	tests := []struct {
+		spec     string
+		server   *httptest.Server
+		fallback bool
+	}{
+		{"online,offline", empty, true},
+		{"online:any,offline", empty, true},
+		{"online:unavailable,offline", empty, false},
+		{"online:unavailable,offline", down, true},
+		{"online:3s:unavailable,offline", down, true},
+	}
+
+	for _, tt := range tests {
+		getenv := func(name string) string {
+			switch name {
+			case NominatimURLEnvVar:
+				return tt.server.URL
+			case NominatimIntervalEnvVar:
+				return "0"
+			}
+			return ""
+		}
+		chain, err := ParseChain(tt.spec, getenv)
+		if err != nil {
+			t.Fatalf("for %q, unexpected error: %v", tt.spec, err)
+		}
+
+		// When Nominatim fails to find a city the gazetteer knows
+		location, err := chain.Geocode("Paris")
+
+		// Then the gazetteer should answer only if the rule lets the chain fall back
+		if tt.fallback && (err != nil || location.Source != "offline") {
+			t.Errorf("for %q, expected an offline answer, got %+v, %v", tt.spec, location, err)
+		}
+		if !tt.fallback && !errors.Is(err, ErrNoResults) {
+			t.Errorf("for %q, expected Nominatim's ErrNoResults to end the chain, got %+v, %v", tt.spec, location, err)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package geocoder
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// cities.tsv is a trimmed GeoNames extract regenerated by `make cities`
+//
+//go:embed cities.tsv
+var citiesTSV []byte
+
+// minPrefixQuery is the shortest query matched against the start of city names
+const minPrefixQuery = 4
+
+// confidentPopulation is the smallest city a whole-name match is trusted for; smaller
+// namesakes, such as Victoria in the Seychelles, are often not the place meant
+const confidentPopulation = 100000
+
+// city is one entry of the embedded gazetteer
+type city struct {
+	name        string
+	latitude    float64
+	longitude   float64
+	countryCode string
+	region      string // first-level administrative division, e.g. Oregon
+	population  int
+}
+
+// GazetteerGeocoder implements the Geocoder interface offline, from an embedded
+// dataset of cities with their alternate names; matches are ranked by population
+type GazetteerGeocoder struct {
+	minPopulation int
+
+	once  sync.Once
+	index map[string][]*city // folded name, ASCII name or alternate name → cities
+	keys  []string           // sorted keys of index, for prefix matches
+	err   error
+}
+
+// NewGazetteerGeocoder creates a new GazetteerGeocoder over the embedded cities
+func NewGazetteerGeocoder() *GazetteerGeocoder {
+	return &GazetteerGeocoder{}
+}
+
+// WithMinPopulation ignores cities smaller than population
+func (g *GazetteerGeocoder) WithMinPopulation(population int) *GazetteerGeocoder {
+	g.minPopulation = population
+	return g
+}
+
+// Geocode converts a city name such as "Zürich", "Bombay" or "Paris, US" to the
+// coordinates of the most populous matching city
+func (g *GazetteerGeocoder) Geocode(query string) (*domain.Location, error) {
+	candidates, err := g.GeocodeCandidates(query, 1)
+	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+// GeocodeCandidates returns up to limit cities matching the query, most populous first.
+// Whole names are preferred; queries of four or more letters also match the start of a
+// name
+func (g *GazetteerGeocoder) GeocodeCandidates(query string, limit int) ([]*domain.Location, error) {
+	candidates, _, err := g.GeocodeConfidentCandidates(query, limit)
+	return candidates, err
+}
+
+// GeocodeConfidentCandidates is GeocodeCandidates, also reporting whether the match is
+// sure: a whole name of a city of at least 100000 people. Prefix matches and small
+// namesakes are guesses a chain checks with the providers after it
+func (g *GazetteerGeocoder) GeocodeConfidentCandidates(query string, limit int) ([]*domain.Location, bool, error) {
+	g.once.Do(g.load)
+	if g.err != nil {
+		return nil, false, fmt.Errorf("geocoding failed: %w", g.err)
+	}
+
+	name, country := splitCountry(query)
+	key := gazetteerKey(name)
+	if key == "" {
+		return nil, false, fmt.Errorf("%w for: %s", ErrNoResults, query)
+	}
+
+	candidates := g.filter(g.index[key], country)
+	exact := len(candidates) > 0
+	if !exact && len(key) >= minPrefixQuery {
+		candidates = g.filter(g.prefixMatches(key), country)
+	}
+	if len(candidates) == 0 {
+		return nil, false, fmt.Errorf("%w for: %s", ErrNoResults, query)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].population > candidates[j].population
+	})
+	confident := exact && candidates[0].population >= confidentPopulation
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	locations := make([]*domain.Location, 0, len(candidates))
+	for _, c := range candidates {
+		location, err := domain.NewLocation(c.name, c.latitude, c.longitude)
+		if err != nil {
+			return nil, false, err
+		}
+		location.CountryCode = c.countryCode
+		location.DisplayName = c.displayName()
+		locations = append(locations, location)
+	}
+	return locations, confident, nil
+}
+
+// displayName names the city with its region and country, e.g. "Portland, Oregon, United
+// States", leaving out regions named after the city such as Tokyo's
+func (c *city) displayName() string {
+	if c.region == "" || gazetteerKey(c.region) == gazetteerKey(c.name) {
+		return c.name + ", " + domain.CountryName(c.countryCode)
+	}
+	return c.name + ", " + c.region + ", " + domain.CountryName(c.countryCode)
+}
+
+// filter keeps each city once if it is large enough and, when country is set, in that
+// country or region
+func (g *GazetteerGeocoder) filter(cities []*city, country string) []*city {
+	var kept []*city
+	seen := make(map[*city]bool)
+	for _, c := range cities {
+		if seen[c] || c.population < g.minPopulation {
+			continue
+		}
+		seen[c] = true
+		if country != "" && country != strings.ToLower(c.countryCode) &&
+			country != gazetteerKey(domain.CountryName(c.countryCode)) &&
+			country != gazetteerKey(c.region) {
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+// prefixMatches returns the cities with a name starting with key
+func (g *GazetteerGeocoder) prefixMatches(key string) []*city {
+	var matches []*city
+	for i := sort.SearchStrings(g.keys, key); i < len(g.keys) && strings.HasPrefix(g.keys[i], key); i++ {
+		matches = append(matches, g.index[g.keys[i]]...)
+	}
+	return matches
+}
+
+// load parses the embedded dataset and indexes every city under each of its names
+func (g *GazetteerGeocoder) load() {
+	g.index = make(map[string][]*city)
+	add := func(name string, c *city) {
+		key := gazetteerKey(name)
+		if key == "" {
+			return
+		}
+		for _, existing := range g.index[key] {
+			if existing == c {
+				return
+			}
+		}
+		g.index[key] = append(g.index[key], c)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(citiesTSV))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		c, names, err := parseCity(text)
+		if err != nil {
+			g.err = fmt.Errorf("cities.tsv line %d: %w", line, err)
+			return
+		}
+		for _, name := range names {
+			add(name, c)
+		}
+	}
+
+	for key := range g.index {
+		g.keys = append(g.keys, key)
+	}
+	sort.Strings(g.keys)
+}
+
+// parseCity reads one line of name, ASCII name, alternate names, latitude, longitude,
+// country code, region and population, returning the city and every name it goes by
+func parseCity(line string) (*city, []string, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) != 8 {
+		return nil, nil, fmt.Errorf("want 8 fields, got %d", len(fields))
+	}
+	lat, err := strconv.ParseFloat(fields[3], 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid latitude: %s", fields[3])
+	}
+	lng, err := strconv.ParseFloat(fields[4], 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid longitude: %s", fields[4])
+	}
+	population, err := strconv.Atoi(fields[7])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid population: %s", fields[7])
+	}
+
+	names := []string{fields[0], fields[1]}
+	if fields[2] != "" {
+		names = append(names, strings.Split(fields[2], ",")...)
+	}
+	return &city{
+		name:        fields[0],
+		latitude:    lat,
+		longitude:   lng,
+		countryCode: fields[5],
+		region:      fields[6],
+		population:  population,
+	}, names, nil
+}
+
+// gazetteerPunctuation is dropped or spaced out of names before they are compared
+var gazetteerPunctuation = strings.NewReplacer("-", " ", ".", "", "'", "", "’", "")
+
+// gazetteerKey folds a name for lookup, so "St. John's", "st johns" and "ST JOHNS" match
+func gazetteerKey(name string) string {
+	return domain.FoldPlaceName(gazetteerPunctuation.Replace(name))
+}
+
+// splitCountry splits a trailing ", country" off a query such as "Paris, FR",
+// "London, Canada" or "Portland, Maine", returning the place and the folded country or
+// region, if any
+func splitCountry(query string) (string, string) {
+	i := strings.LastIndex(query, ",")
+	if i < 0 {
+		return query, ""
+	}
+	return query[:i], gazetteerKey(query[i+1:])
+}
@@ -0,0 +1,230 @@
+package geocoder
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGazetteerGeocoder_ShouldResolveCitiesOffline(t *testing.T) {
+	tests := []struct {
+		query   string
+		name    string
+		country string
+	}{
+		{"Zurich", "Zürich", "CH"},
+		{"zürich", "Zürich", "CH"},
+		{"Bombay", "Mumbai", "IN"},
+		{"saigon", "Thành phố Hồ Chí Minh", "VN"},
+		{"Lodz", "Łódź", "PL"},
+		{"Munich", "München", "DE"},
+		{"St Johns", "St. John's", "CA"},
+		{"Rio", "Rio de Janeiro", "BR"},
+	}
+
+	geocoder := NewGazetteerGeocoder()
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			// When geocoding a city by any of its names
+			location, err := geocoder.Geocode(tt.query)
+
+			// Then the city should be found with its country
+			if err != nil {
+				t.Fatalf("Geocode(%q) failed: %v", tt.query, err)
+			}
+			if location.Name != tt.name || location.CountryCode != tt.country {
+				t.Errorf("Geocode(%q) = %s, %s; want %s, %s", tt.query, location.Name, location.CountryCode, tt.name, tt.country)
+			}
+		})
+	}
+}
+
+func TestGazetteerGeocoder_ShouldRankByPopulation(t *testing.T) {
+	// Given a name shared by cities on both sides of the Atlantic
+	geocoder := NewGazetteerGeocoder()
+
+	// When geocoding it without a country
+	location, err := geocoder.Geocode("Paris")
+
+	// Then the most populous city should win
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if location.CountryCode != "FR" || location.Latitude < 48 || location.Latitude > 49 {
+		t.Errorf("expected Paris, France, got %+v", location)
+	}
+}
+
+func TestGazetteerGeocoder_ShouldFilterByCountry(t *testing.T) {
+	geocoder := NewGazetteerGeocoder()
+	for _, query := range []string{"London, CA", "London, Canada"} {
+		// When the query names the country
+		location, err := geocoder.Geocode(query)
+
+		// Then only cities in that country should match
+		if err != nil {
+			t.Fatalf("Geocode(%q) failed: %v", query, err)
+		}
+		if location.CountryCode != "CA" {
+			t.Errorf("Geocode(%q) = %+v, want London, Ontario", query, location)
+		}
+	}
+}
+
+func TestGazetteerGeocoder_ShouldMatchPrefixesOfLongerQueriesOnly(t *testing.T) {
+	geocoder := NewGazetteerGeocoder()
+
+	// Given the start of a city name
+	location, err := geocoder.Geocode("Johannes")
+	if err != nil || location.Name != "Johannesburg" {
+		t.Errorf("expected Johannesburg for a prefix, got %+v, %v", location, err)
+	}
+
+	// But a short fragment should not match whatever starts with it
+	if location, err := geocoder.Geocode("Jo"); err == nil {
+		t.Errorf("expected no match for a two-letter fragment, got %+v", location)
+	}
+}
+
+func TestGazetteerGeocoder_ShouldOnlyBeConfidentOfWholeNamesOfLargeCities(t *testing.T) {
+	tests := []struct {
+		query     string
+		confident bool
+	}{
+		{"Paris", true},
+		{"Bombay", true},
+		{"Johannes", false},
+		{"Victoria", false},
+	}
+
+	geocoder := NewGazetteerGeocoder()
+	for _, tt := range tests {
+		// When geocoding a whole name, a prefix or a small city
+		_, confident, err := geocoder.GeocodeConfidentCandidates(tt.query, 1)
+
+		// Then only large cities matched by name should be sure
+		if err != nil {
+			t.Fatalf("GeocodeConfidentCandidates(%q) failed: %v", tt.query, err)
+		}
+		if confident != tt.confident {
+			t.Errorf("GeocodeConfidentCandidates(%q) confident = %v, want %v", tt.query, confident, tt.confident)
+		}
+	}
+}
+
+func TestGazetteerGeocoder_ShouldRespectMinimumPopulation(t *testing.T) {
+	// Given a gazetteer limited to large cities
+	geocoder := NewGazetteerGeocoder().WithMinPopulation(1000000)
+
+	// When geocoding a small capital
+	location, err := geocoder.Geocode("Valletta")
+
+	// Then it should not be found
+	if err == nil {
+		t.Errorf("expected Valletta to be filtered out, got %+v", location)
+	}
+}
+
+func TestGazetteerGeocoder_ShouldTellNamesakesApartByRegion(t *testing.T) {
+	// Given two cities named Portland in the same country
+	geocoder := NewGazetteerGeocoder()
+
+	// When asking for candidates
+	candidates, _, err := geocoder.GeocodeConfidentCandidates("Portland", 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then each should be named with its state
+	if len(candidates) != 2 || candidates[0].DisplayName != "Portland, Oregon, United States" ||
+		candidates[1].DisplayName != "Portland, Maine, United States" {
+		t.Fatalf("unexpected candidates %+v", candidates)
+	}
+
+	// And the state should pick one, as a country does
+	if location, err := geocoder.Geocode("Portland, Maine"); err != nil || location.Longitude != -70.25533 {
+		t.Errorf("expected Portland, Maine, got %+v, %v", location, err)
+	}
+
+	// But regions named after their city should not be repeated
+	if location, _ := geocoder.Geocode("Tokyo"); location == nil || location.DisplayName != "Tokyo, Japan" {
+		t.Errorf("expected Tokyo, Japan, got %+v", location)
+	}
+}
+
+func TestGazetteerGeocoder_ShouldFailForUnknownPlaces(t *testing.T) {
+	geocoder := NewGazetteerGeocoder()
+	for _, query := range []string{"", "  ", "XYZ123NotARealPlace456", "Eiffel Tower"} {
+		if location, err := geocoder.Geocode(query); err == nil {
+			t.Errorf("expected error for %q, got %+v", query, location)
+		}
+	}
+}
+
+func TestGazetteerGeocoder_ShouldListCandidatesByPopulation(t *testing.T) {
+	// Given a name shared by cities in several countries
+	geocoder := NewGazetteerGeocoder()
+
+	// When asking for candidates
+	candidates, err := geocoder.GeocodeCandidates("Hyderabad", 5)
+
+	// Then they should come most populous first, named with their region and country
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %d", len(candidates))
+	}
+	if candidates[0].DisplayName != "Hyderabad, Telangana, India" || candidates[1].DisplayName != "Hyderabad, Sindh, Pakistan" {
+		t.Errorf("unexpected candidates %q, %q", candidates[0].DisplayName, candidates[1].DisplayName)
+	}
+
+	// And the limit should be respected
+	if candidates, _ := geocoder.GeocodeCandidates("Springfield", 2); len(candidates) != 2 {
+		t.Errorf("expected 2 of the Springfields, got %d", len(candidates))
+	}
+}
+
+func TestCitiesTSV_ShouldRankByPopulationAndStateOnlyItsRealCutoff(t *testing.T) {
+	// Given the embedded dataset
+	var cutoff, previous int
+	rows := 0
+	generated := false
+	for i, line := range strings.Split(strings.TrimSuffix(string(citiesTSV), "\n"), "\n") {
+		if strings.HasPrefix(line, "# Generated by scripts/cities.sh") {
+			generated = true
+			continue
+		}
+		if n, ok := strings.CutPrefix(line, "# Minimum population: "); ok {
+			cutoff, _ = strconv.Atoi(n)
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// When reading each city
+		c, _, err := parseCity(line)
+
+		// Then it should parse and be no more populous than the one before it
+		if err != nil {
+			t.Fatalf("line %d: %v", i+1, err)
+		}
+		if rows > 0 && c.population > previous {
+			t.Errorf("line %d: %s (%d) ranks below a smaller city", i+1, c.name, c.population)
+		}
+		previous = c.population
+		rows++
+	}
+
+	// And the header should state the population cutoff only if the script cut it there
+	if generated && cutoff <= 0 {
+		t.Errorf("expected a \"# Minimum population: N\" header line")
+	}
+	if !generated && cutoff > 0 {
+		t.Errorf("expected no population cutoff in a dataset scripts/cities.sh did not generate")
+	}
+	if rows == 0 {
+		t.Errorf("expected cities")
+	}
+}
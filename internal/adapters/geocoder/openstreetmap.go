@@ -1,34 +1,175 @@
 package geocoder
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/codingsince1985/geo-golang"
-	"github.com/codingsince1985/geo-golang/openstreetmap"
 	"github.com/loginx/alfred-timein/internal/domain"
 )
 
-// OpenStreetMapGeocoder implements the Geocoder interface using OpenStreetMap
+const (
+	// DefaultNominatimURL is OpenStreetMap's public Nominatim instance
+	DefaultNominatimURL = "https://nominatim.openstreetmap.org/"
+	// DefaultUserAgent identifies the workflow to Nominatim, as its usage policy asks
+	DefaultUserAgent = "alfred-timein (+https://github.com/loginx/alfred-timein)"
+	// DefaultNominatimInterval is the public instance's limit of one request per second
+	DefaultNominatimInterval = time.Second
+
+	nominatimRequestTimeout = 8 * time.Second
+)
+
+// RateLimiter spaces out requests to a geocoding service
+type RateLimiter interface {
+	Wait() error
+}
+
+// OpenStreetMapGeocoder implements the Geocoder interface using Nominatim, the
+// OpenStreetMap search service
 type OpenStreetMapGeocoder struct {
-	geocoder geo.Geocoder
+	baseURL        string
+	userAgent      string
+	email          string
+	acceptLanguage string
+	client         *http.Client
+	limiter        RateLimiter
 }
 
-// NewOpenStreetMapGeocoder creates a new OpenStreetMapGeocoder
+// NewOpenStreetMapGeocoder creates a new OpenStreetMapGeocoder for the public Nominatim
+// instance; it is not rate limited until given a limiter
 func NewOpenStreetMapGeocoder() *OpenStreetMapGeocoder {
 	return &OpenStreetMapGeocoder{
-		geocoder: openstreetmap.Geocoder(),
+		baseURL:   DefaultNominatimURL,
+		userAgent: DefaultUserAgent,
+		client:    &http.Client{Timeout: nominatimRequestTimeout},
 	}
 }
 
+// WithBaseURL points the geocoder at another Nominatim instance, e.g. a self-hosted one
+func (g *OpenStreetMapGeocoder) WithBaseURL(baseURL string) *OpenStreetMapGeocoder {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	g.baseURL = baseURL
+	return g
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func (g *OpenStreetMapGeocoder) WithUserAgent(userAgent string) *OpenStreetMapGeocoder {
+	g.userAgent = userAgent
+	return g
+}
+
+// WithEmail sends a contact address with every request, as Nominatim asks of heavy users
+func (g *OpenStreetMapGeocoder) WithEmail(email string) *OpenStreetMapGeocoder {
+	g.email = email
+	return g
+}
+
+// WithAcceptLanguage asks for place names in the given languages, e.g. "de,en"
+func (g *OpenStreetMapGeocoder) WithAcceptLanguage(languages string) *OpenStreetMapGeocoder {
+	g.acceptLanguage = languages
+	return g
+}
+
+// WithHTTPClient makes requests through client instead of the default one
+func (g *OpenStreetMapGeocoder) WithHTTPClient(client *http.Client) *OpenStreetMapGeocoder {
+	g.client = client
+	return g
+}
+
+// WithRateLimiter waits on limiter before every request
+func (g *OpenStreetMapGeocoder) WithRateLimiter(limiter RateLimiter) *OpenStreetMapGeocoder {
+	g.limiter = limiter
+	return g
+}
+
+// nominatimPlace is one result of Nominatim's search API
+type nominatimPlace struct {
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	DisplayName string `json:"display_name"`
+	Address     struct {
+		CountryCode string `json:"country_code"`
+	} `json:"address"`
+}
+
 // Geocode converts a location query to coordinates
 func (g *OpenStreetMapGeocoder) Geocode(query string) (*domain.Location, error) {
-	result, err := g.geocoder.Geocode(query)
+	candidates, err := g.GeocodeCandidates(query, 1)
 	if err != nil {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+// GeocodeCandidates returns up to limit places matching the query in Nominatim's order
+func (g *OpenStreetMapGeocoder) GeocodeCandidates(query string, limit int) ([]*domain.Location, error) {
+	if limit <= 0 {
+		limit = 1
+	}
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("%w for: %s", ErrNoResults, query)
+	}
+	if g.limiter != nil {
+		if err := g.limiter.Wait(); err != nil {
+			return nil, fmt.Errorf("geocoding failed: %w", err)
+		}
+	}
+
+	params := url.Values{
+		"q":              {query},
+		"format":         {"jsonv2"},
+		"limit":          {strconv.Itoa(limit)},
+		"addressdetails": {"1"},
+	}
+	if g.email != "" {
+		params.Set("email", g.email)
+	}
+	req, err := http.NewRequest(http.MethodGet, g.baseURL+"search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("geocoding failed: %w", err)
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+	if g.acceptLanguage != "" {
+		req.Header.Set("Accept-Language", g.acceptLanguage)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("geocoding failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geocoding failed: %s", resp.Status)
+	}
+
+	var places []nominatimPlace
+	if err := json.NewDecoder(resp.Body).Decode(&places); err != nil {
 		return nil, fmt.Errorf("geocoding failed: %w", err)
 	}
-	if result == nil {
-		return nil, fmt.Errorf("no results found for: %s", query)
+	if len(places) == 0 {
+		return nil, fmt.Errorf("%w for: %s", ErrNoResults, query)
 	}
 
-	return domain.NewLocation(query, float64(result.Lat), float64(result.Lng))
-}
\ No newline at end of file
+	locations := make([]*domain.Location, 0, len(places))
+	for _, place := range places {
+		lat, latErr := strconv.ParseFloat(place.Lat, 64)
+		lng, lngErr := strconv.ParseFloat(place.Lon, 64)
+		if latErr != nil || lngErr != nil {
+			return nil, fmt.Errorf("geocoding failed: invalid coordinates %q,%q", place.Lat, place.Lon)
+		}
+		location, err := domain.NewLocation(query, lat, lng)
+		if err != nil {
+			return nil, err
+		}
+		location.CountryCode = strings.ToUpper(place.Address.CountryCode)
+		location.DisplayName = place.DisplayName
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
@@ -1,6 +1,9 @@
 package geocoder
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -80,4 +83,100 @@ func TestOpenStreetMapGeocoder_ShouldHandleLandmarks(t *testing.T) {
 	if location.Latitude < 48 || location.Latitude > 49 {
 		t.Errorf("Expected Eiffel Tower latitude in Paris range, got %f", location.Latitude)
 	}
-}
\ No newline at end of file
+}
+
+// countingLimiter records how often the geocoder waited on it
+type countingLimiter struct{ waits int }
+
+func (l *countingLimiter) Wait() error {
+	l.waits++
+	return nil
+}
+
+func TestOpenStreetMapGeocoder_ShouldSendConfiguredRequest(t *testing.T) {
+	// Given a stand-in Nominatim that records the request
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Write([]byte(`[{"lat":"48.8588897","lon":"2.3200410","address":{"country_code":"fr"}}]`))
+	}))
+	defer server.Close()
+	limiter := &countingLimiter{}
+	geocoder := NewOpenStreetMapGeocoder().
+		WithBaseURL(server.URL+"/nominatim").
+		WithUserAgent("acme-oncall/2.0").
+		WithEmail("ops@example.com").
+		WithAcceptLanguage("de,en").
+		WithRateLimiter(limiter)
+
+	// When geocoding a query that needs escaping
+	location, err := geocoder.Geocode("Paris & Co")
+
+	// Then the request should carry the configuration
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	q := got.URL.Query()
+	if got.URL.Path != "/nominatim/search" || q.Get("q") != "Paris & Co" || q.Get("email") != "ops@example.com" || q.Get("limit") != "1" {
+		t.Errorf("unexpected request %s", got.URL)
+	}
+	if got.UserAgent() != "acme-oncall/2.0" || got.Header.Get("Accept-Language") != "de,en" {
+		t.Errorf("unexpected headers %v", got.Header)
+	}
+	if limiter.waits != 1 {
+		t.Errorf("expected one wait on the rate limiter, got %d", limiter.waits)
+	}
+
+	// And the first result should become the location
+	if location.Name != "Paris & Co" || location.Latitude != 48.8588897 || location.Longitude != 2.3200410 || location.CountryCode != "FR" {
+		t.Errorf("unexpected location %+v", location)
+	}
+}
+
+func TestOpenStreetMapGeocoder_ShouldDistinguishNoResultsFromFailures(t *testing.T) {
+	// Given a stand-in Nominatim that finds nothing
+	empty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer empty.Close()
+	if _, err := NewOpenStreetMapGeocoder().WithBaseURL(empty.URL).Geocode("Atlantis"); !errors.Is(err, ErrNoResults) {
+		t.Errorf("expected ErrNoResults, got %v", err)
+	}
+
+	// And one that refuses the request
+	throttled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer throttled.Close()
+	_, err := NewOpenStreetMapGeocoder().WithBaseURL(throttled.URL).Geocode("Paris")
+	if err == nil || errors.Is(err, ErrNoResults) {
+		t.Errorf("expected a failure other than ErrNoResults, got %v", err)
+	}
+}
+
+func TestOpenStreetMapGeocoder_ShouldReturnCandidatesInOrder(t *testing.T) {
+	// Given a stand-in Nominatim with two matches
+	var limit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit = r.URL.Query().Get("limit")
+		w.Write([]byte(`[
+			{"lat":"45.5202471","lon":"-122.674194","display_name":"Portland, Multnomah County, Oregon, United States","address":{"country_code":"us"}},
+			{"lat":"43.6573605","lon":"-70.2586618","display_name":"Portland, Cumberland County, Maine, United States","address":{"country_code":"us"}}
+		]`))
+	}))
+	defer server.Close()
+
+	// When asking for candidates
+	candidates, err := NewOpenStreetMapGeocoder().WithBaseURL(server.URL).GeocodeCandidates("Portland", 5)
+
+	// Then each should carry its display name, in Nominatim's order
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limit != "5" || len(candidates) != 2 {
+		t.Fatalf("expected 2 candidates for limit 5, got %d for limit %s", len(candidates), limit)
+	}
+	if candidates[0].DisplayName != "Portland, Multnomah County, Oregon, United States" || candidates[1].Longitude != -70.2586618 {
+		t.Errorf("unexpected candidates %+v, %+v", candidates[0], candidates[1])
+	}
+}
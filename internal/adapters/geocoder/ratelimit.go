@@ -0,0 +1,113 @@
+package geocoder
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// lockRetryInterval is how often a process waiting for the lock file tries again
+	lockRetryInterval = 10 * time.Millisecond
+	// staleLockAge is how old a lock file must be before a crashed holder is assumed. The
+	// lock is only held to read and write the state file, well under the request timeout
+	staleLockAge = time.Second
+	// maxLockWait bounds how long a process waits for another to release the lock, and
+	// how far ahead a reserved slot may lie before the clock is assumed to have gone back
+	maxLockWait = 30 * time.Second
+)
+
+// FileRateLimiter spaces out requests made by every process sharing a state file. Alfred
+// runs a new process per keystroke, so an in-memory limiter would not see the others:
+// the time of the last request lives in the state file, guarded by a lock file next to it
+type FileRateLimiter struct {
+	path     string
+	interval time.Duration
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+// NewFileRateLimiter creates a limiter allowing one request per interval across all
+// processes using the state file at path
+func NewFileRateLimiter(path string, interval time.Duration) *FileRateLimiter {
+	return &FileRateLimiter{
+		path:     path,
+		interval: interval,
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+// Wait blocks until a request may be made. The slot is reserved under the lock, by
+// recording the time the request will be made, and waited for after releasing it, so a
+// process killed while waiting cannot hold up the others
+func (l *FileRateLimiter) Wait() error {
+	if l.interval <= 0 {
+		return nil
+	}
+
+	unlock, err := l.lock()
+	if err != nil {
+		return err
+	}
+
+	now := l.now()
+	slot := now
+	if last, ok := l.lastRequest(); ok && last.Add(l.interval).After(now) {
+		slot = last.Add(l.interval)
+		if slot.Sub(now) > maxLockWait {
+			slot = now.Add(l.interval) // a clock set back should not stall requests
+		}
+	}
+	err = os.WriteFile(l.path, []byte(strconv.FormatInt(slot.UnixNano(), 10)+"\n"), 0o644)
+	unlock()
+	if err != nil {
+		return err
+	}
+
+	if wait := slot.Sub(now); wait > 0 {
+		l.sleep(wait)
+	}
+	return nil
+}
+
+// lastRequest reads the time of the last request from the state file
+func (l *FileRateLimiter) lastRequest() (time.Time, bool) {
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	nanos, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+// lock creates the lock file exclusively, waiting for other holders and breaking
+// locks left behind by processes that died holding them
+func (l *FileRateLimiter) lock() (func(), error) {
+	lockPath := l.path + ".lock"
+	deadline := l.now().Add(maxLockWait)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("rate limiter: %w", err)
+		}
+		if info, statErr := os.Stat(lockPath); statErr == nil && l.now().Sub(info.ModTime()) > staleLockAge {
+			os.Remove(lockPath)
+			continue
+		}
+		if l.now().After(deadline) {
+			return nil, fmt.Errorf("rate limiter: timed out waiting for %s", lockPath)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
@@ -0,0 +1,128 @@
+package geocoder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeTime is a clock whose sleeps advance it instead of blocking
+type fakeTime struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (f *fakeTime) Now() time.Time { return f.now }
+
+func (f *fakeTime) Sleep(d time.Duration) {
+	f.sleeps = append(f.sleeps, d)
+	f.now = f.now.Add(d)
+}
+
+func newTestLimiter(path string, interval time.Duration, clock *fakeTime) *FileRateLimiter {
+	l := NewFileRateLimiter(path, interval)
+	l.now, l.sleep = clock.Now, clock.Sleep
+	return l
+}
+
+func TestFileRateLimiter_ShouldSpaceRequestsAcrossProcesses(t *testing.T) {
+	// Given two limiters sharing a state file, as two Alfred processes would
+	path := filepath.Join(t.TempDir(), "nominatim")
+	clock := &fakeTime{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	first := newTestLimiter(path, time.Second, clock)
+	second := newTestLimiter(path, time.Second, clock)
+
+	// When the first requests and the second follows 300ms later
+	if err := first.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock.now = clock.now.Add(300 * time.Millisecond)
+	if err := second.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then only the second should wait, for the rest of the second
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != 700*time.Millisecond {
+		t.Errorf("expected a single 700ms wait, got %v", clock.sleeps)
+	}
+
+	// And the lock file should be gone
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("expected the lock file to be removed, got %v", err)
+	}
+}
+
+func TestFileRateLimiter_ShouldNotWaitAfterTheInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nominatim")
+	clock := &fakeTime{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	l := newTestLimiter(path, time.Second, clock)
+
+	l.Wait()
+	clock.now = clock.now.Add(2 * time.Second)
+	l.Wait()
+
+	if len(clock.sleeps) != 0 {
+		t.Errorf("expected no waits, got %v", clock.sleeps)
+	}
+}
+
+func TestFileRateLimiter_ShouldBreakStaleLocks(t *testing.T) {
+	// Given a lock left behind by a process that died holding it
+	path := filepath.Join(t.TempDir(), "nominatim")
+	if err := os.WriteFile(path+".lock", nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Minute)
+	os.Chtimes(path+".lock", old, old)
+
+	// Then waiting should still succeed
+	if err := NewFileRateLimiter(path, time.Millisecond).Wait(); err != nil {
+		t.Errorf("expected the stale lock to be broken, got %v", err)
+	}
+}
+
+func TestFileRateLimiter_ShouldDoNothingWhenDisabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nominatim")
+	if err := NewFileRateLimiter(path, 0).Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected no state file for a disabled limiter")
+	}
+}
+
+func TestFileRateLimiter_ShouldReserveSlotsAndWaitWithoutTheLock(t *testing.T) {
+	// Given three limiters sharing a state file and a clock that checks the lock while sleeping
+	path := filepath.Join(t.TempDir(), "nominatim")
+	clock := &fakeTime{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	var locked []bool
+	limiters := make([]*FileRateLimiter, 3)
+	for i := range limiters {
+		limiters[i] = newTestLimiter(path, time.Second, clock)
+		limiters[i].sleep = func(d time.Duration) {
+			_, err := os.Stat(path + ".lock")
+			locked = append(locked, err == nil)
+			clock.sleeps = append(clock.sleeps, d)
+		}
+	}
+
+	// When all three ask for a slot at the same moment, before any wait has elapsed
+	for _, l := range limiters {
+		if err := l.Wait(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Then each should be given the slot after the one reserved before it
+	if len(clock.sleeps) != 2 || clock.sleeps[0] != time.Second || clock.sleeps[1] != 2*time.Second {
+		t.Errorf("expected waits of 1s and 2s, got %v", clock.sleeps)
+	}
+
+	// And none should hold the lock while waiting
+	for i, held := range locked {
+		if held {
+			t.Errorf("expected wait %d to happen without the lock", i)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package homezone
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+const (
+	// EnvVar names the workflow variable that overrides the detected home timezone
+	EnvVar = "TIMEIN_HOME"
+
+	defaultLocaltimePath = "/etc/localtime"
+)
+
+// SystemDetector resolves the user's home timezone from an explicit setting,
+// the TIMEIN_HOME and TZ environment variables, or /etc/localtime
+type SystemDetector struct {
+	getenv        func(string) string
+	localtimePath string
+}
+
+// NewSystemDetector creates a new SystemDetector
+func NewSystemDetector() *SystemDetector {
+	return &SystemDetector{
+		getenv:        os.Getenv,
+		localtimePath: defaultLocaltimePath,
+	}
+}
+
+// Detect returns the home timezone, preferring explicit over environment over system settings
+func (d *SystemDetector) Detect(explicit string) (*domain.Timezone, error) {
+	if strings.TrimSpace(explicit) != "" {
+		return domain.NewTimezone(explicit)
+	}
+
+	for _, name := range []string{d.getenv(EnvVar), zoneFromTZ(d.getenv("TZ"))} {
+		if tz, err := domain.NewTimezone(name); err == nil {
+			return tz, nil
+		}
+	}
+
+	if name := zoneFromPath(d.localtimePath); name != "" {
+		if tz, err := domain.NewTimezone(name); err == nil {
+			return tz, nil
+		}
+	}
+
+	if name := time.Local.String(); name != "Local" {
+		if tz, err := domain.NewTimezone(name); err == nil {
+			return tz, nil
+		}
+	}
+	return domain.NewTimezone("UTC")
+}
+
+// zoneFromTZ extracts a zone name from a TZ value such as ":Europe/Paris"
+// or a path below a zoneinfo directory
+func zoneFromTZ(tz string) string {
+	tz = strings.TrimPrefix(strings.TrimSpace(tz), ":")
+	if strings.HasPrefix(tz, "/") {
+		return zoneInfoSuffix(tz)
+	}
+	// POSIX offsets such as "UTC+5" count west of UTC, unlike timein's notation
+	if _, ok := domain.ParseUTCOffset(tz); ok {
+		return ""
+	}
+	return tz
+}
+
+// zoneFromPath resolves a localtime symlink to the zone name it points at
+func zoneFromPath(path string) string {
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return ""
+	}
+	return zoneInfoSuffix(target)
+}
+
+// zoneInfoSuffix returns the part of a path after its "zoneinfo/" directory
+func zoneInfoSuffix(path string) string {
+	const marker = "zoneinfo/"
+	if i := strings.LastIndex(path, marker); i >= 0 {
+		return strings.TrimPrefix(path[i+len(marker):], "posix/")
+	}
+	return ""
+}
@@ -0,0 +1,93 @@
+package homezone
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestDetector(env map[string]string, localtimePath string) *SystemDetector {
+	return &SystemDetector{
+		getenv:        func(key string) string { return env[key] },
+		localtimePath: localtimePath,
+	}
+}
+
+func TestSystemDetector_ShouldPreferExplicitSetting(t *testing.T) {
+	// Given a detector with environment settings
+	d := newTestDetector(map[string]string{EnvVar: "Asia/Tokyo", "TZ": "Europe/Paris"}, "")
+
+	// When an explicit zone is given
+	tz, err := d.Detect("America/Chicago")
+
+	// Then it should win
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tz.String() != "America/Chicago" {
+		t.Errorf("expected America/Chicago, got %s", tz)
+	}
+
+	// And an invalid explicit zone should be an error rather than silently ignored
+	if _, err := d.Detect("Not/AZone"); err == nil {
+		t.Error("expected error for invalid explicit zone")
+	}
+}
+
+func TestSystemDetector_ShouldFallBackThroughEnvironment(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+	}{
+		{"workflow variable", map[string]string{EnvVar: "Asia/Tokyo", "TZ": "Europe/Paris"}, "Asia/Tokyo"},
+		{"TZ name", map[string]string{"TZ": "Europe/Paris"}, "Europe/Paris"},
+		{"TZ with colon", map[string]string{"TZ": ":Europe/Paris"}, "Europe/Paris"},
+		{"TZ path", map[string]string{"TZ": "/usr/share/zoneinfo/Asia/Kolkata"}, "Asia/Kolkata"},
+	}
+
+	for _, test := range tests {
+		tz, err := newTestDetector(test.env, "").Detect("")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if tz.String() != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, tz)
+		}
+	}
+}
+
+func TestSystemDetector_ShouldReadLocaltimeSymlink(t *testing.T) {
+	// Given a localtime symlink into a zoneinfo tree
+	dir := t.TempDir()
+	zoneDir := filepath.Join(dir, "zoneinfo", "Australia")
+	if err := os.MkdirAll(zoneDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(zoneDir, "Adelaide"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "localtime")
+	if err := os.Symlink(filepath.Join(zoneDir, "Adelaide"), link); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	// When no environment settings exist
+	tz, err := newTestDetector(map[string]string{}, link).Detect("")
+
+	// Then the symlink target should be used
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tz.String() != "Australia/Adelaide" {
+		t.Errorf("expected Australia/Adelaide, got %s", tz)
+	}
+}
+
+func TestZoneFromTZ_ShouldIgnorePOSIXOffsets(t *testing.T) {
+	// POSIX "UTC+5" is five hours west of UTC, the opposite of timein's notation
+	if name := zoneFromTZ("UTC+5"); name != "" {
+		t.Errorf("expected POSIX offset to be ignored, got %s", name)
+	}
+}
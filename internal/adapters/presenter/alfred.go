@@ -2,21 +2,55 @@ package presenter
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/loginx/alfred-timein/internal/alfred"
 	"github.com/loginx/alfred-timein/internal/domain"
-	"github.com/tkuchiki/go-timezone"
+	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 const alfredCacheSeconds = 604800 // 7 days
 
 // AlfredFormatter formats output for Alfred Script Filter
-type AlfredFormatter struct{}
+type AlfredFormatter struct {
+	home      *domain.Timezone
+	schedule  domain.Schedule
+	fixedTime bool
+	query     string // the geotz query world clock rows are candidates for, if any
+}
 
 // NewAlfredFormatter creates a new AlfredFormatter
 func NewAlfredFormatter() *AlfredFormatter {
-	return &AlfredFormatter{}
+	return &AlfredFormatter{schedule: domain.DefaultSchedule}
+}
+
+// WithHomeTimezone makes the formatter show each time relative to the user's home timezone
+func (f *AlfredFormatter) WithHomeTimezone(home *domain.Timezone) *AlfredFormatter {
+	f.home = home
+	return f
+}
+
+// WithSchedule sets the working and sleeping hours used to say what people are probably doing
+func (f *AlfredFormatter) WithSchedule(schedule domain.Schedule) *AlfredFormatter {
+	f.schedule = schedule
+	return f
+}
+
+// WithFixedTime tells the formatter that times are shown at a fixed instant, such as
+// --at, rather than now
+func (f *AlfredFormatter) WithFixedTime(fixed bool) *AlfredFormatter {
+	f.fixedTime = fixed
+	return f
+}
+
+// WithCandidatesFor tells the formatter that world clock rows are the candidates geotz
+// found for query, so each item carries the choice and city variables the workflow
+// passes to geotz --choose to remember the one picked
+func (f *AlfredFormatter) WithCandidatesFor(query string) *AlfredFormatter {
+	f.query = strings.TrimSpace(query)
+	return f
 }
 
 // FormatTimezoneInfo formats timezone information for Alfred
@@ -28,6 +62,12 @@ func (f *AlfredFormatter) FormatTimezoneInfo(timezone *domain.Timezone, city str
 	if cached {
 		subtitle += " (cached)"
 	}
+	if timezone.Alias != "" {
+		subtitle += " · alias " + timezone.Alias
+	}
+	if !cached && timezone.Place != nil && timezone.Place.Source != "" {
+		subtitle += " · via " + timezone.Place.Source
+	}
 
 	item := alfred.Item{
 		Title:    timezone.String(),
@@ -37,31 +77,80 @@ func (f *AlfredFormatter) FormatTimezoneInfo(timezone *domain.Timezone, city str
 			"city": city,
 		},
 	}
+	if timezone.Place != nil {
+		item.Variables["coordinates"] = timezone.Place.Coordinates()
+		if timezone.Place.Source != "" {
+			item.Variables["geocoder"] = timezone.Place.Source
+		}
+	}
+	if timezone.Alias != "" {
+		item.Variables["alias"] = timezone.Alias
+	}
 
 	out.AddItem(item)
 	return out.ToJSON()
 }
 
-// FormatTimeInfo formats current time information for Alfred
-func (f *AlfredFormatter) FormatTimeInfo(tz *domain.Timezone) ([]byte, error) {
+// FormatTimezoneCandidates lists one item per timezone among places sharing a name; the
+// choice variable is what `geotz --choose` remembers for the query
+func (f *AlfredFormatter) FormatTimezoneCandidates(query string, candidates []*domain.Timezone) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	for _, tz := range candidates {
+		subtitle := query
+		variables := map[string]interface{}{
+			"city":   query,
+			"choice": tz.PlacedName(),
+		}
+		if tz.Place != nil {
+			if tz.Place.DisplayName != "" {
+				subtitle = tz.Place.DisplayName
+			}
+			if tz.Place.Source != "" {
+				subtitle += " · via " + tz.Place.Source
+				variables["geocoder"] = tz.Place.Source
+			}
+			variables["coordinates"] = tz.Place.Coordinates()
+		}
+		out.AddItem(alfred.Item{
+			UID:       tz.String(),
+			Title:     tz.String(),
+			Subtitle:  subtitle,
+			Arg:       tz.String(),
+			Variables: variables,
+		})
+	}
+	return out.ToJSON()
+}
+
+// FormatTimeInfo formats the time at an instant for Alfred
+func (f *AlfredFormatter) FormatTimeInfo(tz *domain.Timezone, at time.Time) ([]byte, error) {
 	loc, err := tz.Location()
 	if err != nil {
 		return nil, err
 	}
 
-	now := time.Now().In(loc)
+	now := at.In(loc)
 	city := tz.City()
 
-	// Get timezone abbreviation
-	tzlib := timezone.New()
-	isDST := tzlib.IsDST(now)
-	abbr, err := tzlib.GetTimezoneAbbreviation(tz.String(), isDST)
-	if err != nil || abbr == "" {
-		abbr = now.Format("MST")
-	}
-
+	abbr := usecases.Abbreviation(tz, now)
 	title := fmt.Sprintf("%s - %s", tz.String(), now.Format("Mon, Jan 2, 3:04 PM"))
 	subtitle := fmt.Sprintf("Current time in %s (%s)", city, abbr)
+	if f.fixedTime {
+		subtitle = fmt.Sprintf("Time in %s (%s)", city, abbr)
+	}
+	if rel := relativeToHome(f.home, now); rel != "" {
+		subtitle += " · " + rel
+	}
+	subtitle += " · " + dayPeriodHint(f.schedule, tz, now)
+	if hint := sunHint(tz, now); hint != "" {
+		subtitle += " · " + hint
+	}
+	if notice := holidayNotice(tz, now); notice != "" {
+		subtitle += " · " + notice
+	}
+	if notice := transitionNotice(tz, now); notice != "" {
+		subtitle += " · " + notice
+	}
 
 	out := alfred.NewScriptFilterOutput()
 	out.Cache = &alfred.CacheConfig{Seconds: 60}
@@ -70,15 +159,400 @@ func (f *AlfredFormatter) FormatTimeInfo(tz *domain.Timezone) ([]byte, error) {
 		Title:    title,
 		Subtitle: subtitle,
 		Arg:      title,
+		Icon:     dayPeriodIcon(f.schedule, tz, now),
 		Variables: map[string]interface{}{
 			"timezone": tz.String(),
 		},
 	}
+	if tz.Alias != "" {
+		item.Variables["alias"] = tz.Alias
+	}
+	// Holding ⌥ shows the day's sunrise, sunset, solar noon and civil twilight
+	if detail := sunDetail(tz, now); detail != "" {
+		item.Mods = map[string]alfred.Mod{"alt": {Arg: title, Subtitle: detail}}
+	}
+
+	out.AddItem(item)
+	return out.ToJSON()
+}
+
+// FormatConversion formats a time conversion for Alfred
+func (f *AlfredFormatter) FormatConversion(conversion *usecases.Conversion) ([]byte, error) {
+	source, target := conversion.Source, conversion.Target
+
+	title := fmt.Sprintf("%s in %s (%s)", target.CurrentTime.Format("Mon, Jan 2, 3:04 PM"), target.City, target.Abbreviation)
+	subtitle := fmt.Sprintf("%s in %s (%s)", source.CurrentTime.Format("Mon, Jan 2, 3:04 PM"), source.City, source.Abbreviation)
 
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 60}
+	item := alfred.Item{
+		Title:    title,
+		Subtitle: subtitle,
+		Arg:      title,
+		Variables: map[string]interface{}{
+			"source_timezone": source.Timezone.String(),
+			"target_timezone": target.Timezone.String(),
+		},
+	}
 	out.AddItem(item)
 	return out.ToJSON()
 }
 
+// FormatWorldClock formats one Alfred item per timezone
+func (f *AlfredFormatter) FormatWorldClock(infos []*usecases.TimezoneInfo) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 60}
+
+	for _, info := range infos {
+		_, offset := info.CurrentTime.Zone()
+		title := fmt.Sprintf("%s - %s", info.City, info.CurrentTime.Format("Mon, Jan 2, 3:04 PM"))
+		subtitle := fmt.Sprintf("%s (%s, %s)", info.Timezone.String(), info.Abbreviation, domain.FormatUTCOffset(offset))
+		if rel := relativeToHome(f.home, info.CurrentTime); rel != "" {
+			subtitle += " · " + rel
+		}
+		subtitle += " · " + dayPeriodHint(f.schedule, info.Timezone, info.CurrentTime)
+		if hint := sunHint(info.Timezone, info.CurrentTime); hint != "" {
+			subtitle += " · " + hint
+		}
+		if notice := holidayNotice(info.Timezone, info.CurrentTime); notice != "" {
+			subtitle += " · " + notice
+		}
+		if notice := transitionNotice(info.Timezone, info.CurrentTime); notice != "" {
+			subtitle += " · " + notice
+		}
+		item := alfred.Item{
+			UID:      info.Timezone.String(),
+			Title:    title,
+			Subtitle: subtitle,
+			Arg:      title,
+			Icon:     dayPeriodIcon(f.schedule, info.Timezone, info.CurrentTime),
+			Variables: map[string]interface{}{
+				"timezone": info.Timezone.String(),
+			},
+		}
+		if f.query != "" {
+			item.Variables["city"] = f.query
+			item.Variables["choice"] = info.Timezone.PlacedName()
+		}
+		out.AddItem(item)
+	}
+	return out.ToJSON()
+}
+
+// FormatOverlap formats one Alfred item per shared meeting slot
+func (f *AlfredFormatter) FormatOverlap(overlap *usecases.Overlap) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 300}
+
+	if len(overlap.Slots) == 0 {
+		out.AddItem(alfred.Item{
+			Title:    "No overlapping working hours",
+			Subtitle: participantSummary(overlap.Participants),
+			Valid:    boolPtr(false),
+		})
+		return out.ToJSON()
+	}
+
+	first := overlap.Participants[0]
+	for _, slot := range overlap.Slots {
+		start, end := localSlot(slot, first)
+		title := fmt.Sprintf("%s, %s–%s %s (%s)", start.Format("Mon, Jan 2"), start.Format("3:04 PM"), end.Format("3:04 PM"), first.Name, formatDuration(slot.Duration()))
+
+		parts := make([]string, 0, len(overlap.Participants)-1)
+		for _, p := range overlap.Participants[1:] {
+			s, e := localSlot(slot, p)
+			parts = append(parts, fmt.Sprintf("%s %s–%s", p.Name, s.Format("3:04 PM"), e.Format("3:04 PM")))
+		}
+
+		out.AddItem(alfred.Item{
+			Title:    title,
+			Subtitle: strings.Join(parts, " · "),
+			Arg:      slot.Start.UTC().Format(time.RFC3339),
+			Variables: map[string]interface{}{
+				"start": slot.Start.UTC().Format(time.RFC3339),
+				"end":   slot.End.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+	return out.ToJSON()
+}
+
+// FormatTransitions formats one Alfred item per UTC-offset transition
+func (f *AlfredFormatter) FormatTransitions(report *usecases.TransitionReport) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 3600}
+
+	if len(report.Transitions) == 0 {
+		out.AddItem(alfred.Item{
+			Title:    fmt.Sprintf("No UTC offset changes in %s", report.Timezone.String()),
+			Subtitle: "This timezone does not observe daylight saving time",
+			Valid:    boolPtr(false),
+		})
+		return out.ToJSON()
+	}
+
+	for i := range report.Transitions {
+		tr := &report.Transitions[i]
+		upcoming, past := describeTransition(tr)
+		kind, when := upcoming, relativeTime(tr.At.Sub(report.Now))
+		if tr.At.Before(report.Now) {
+			kind = past
+		}
+		before, after := transitionWallClocks(tr)
+
+		title := fmt.Sprintf("%s %s (%s→%s)", kind, when, tr.OldAbbreviation, tr.NewAbbreviation)
+		subtitle := fmt.Sprintf("%s %s → %s · %s → %s",
+			before.Format("Mon, Jan 2, 2006"),
+			before.Format("3:04 PM MST"), after.Format("3:04 PM MST"),
+			domain.FormatUTCOffset(tr.OldOffset), domain.FormatUTCOffset(tr.NewOffset))
+
+		out.AddItem(alfred.Item{
+			Title:    title,
+			Subtitle: subtitle,
+			Arg:      tr.At.UTC().Format(time.RFC3339),
+			Variables: map[string]interface{}{
+				"timezone": report.Timezone.String(),
+			},
+		})
+	}
+	return out.ToJSON()
+}
+
+// FormatHistory formats one Alfred item per zone change, or a single item for the period
+// in effect at an instant
+func (f *AlfredFormatter) FormatHistory(history *usecases.ZoneHistory) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 3600}
+
+	if period := history.Period; period != nil {
+		start, end := periodWallClocks(period)
+		subtitle := strings.ToUpper(describePeriod(period)[:1]) + describePeriod(period)[1:]
+		if !start.IsZero() {
+			subtitle += " · since " + start.Format("Mon, Jan 2, 2006 3:04 PM")
+		}
+		if !end.IsZero() {
+			subtitle += " · until " + end.Format("Mon, Jan 2, 2006 3:04 PM")
+		}
+		offset := domain.FormatUTCOffset(period.Offset)
+		out.AddItem(alfred.Item{
+			Title:    fmt.Sprintf("%s (%s) in %s on %s", offset, period.Abbreviation, history.Timezone.String(), history.At.Format("Mon, Jan 2, 2006")),
+			Subtitle: subtitle,
+			Arg:      offset,
+			Variables: map[string]interface{}{
+				"timezone": history.Timezone.String(),
+			},
+		})
+		return out.ToJSON()
+	}
+
+	if len(history.Changes) == 0 {
+		out.AddItem(alfred.Item{
+			Title:    fmt.Sprintf("No offset or abbreviation changes in %s", history.Timezone.String()),
+			Subtitle: "Nothing changed in this range",
+			Valid:    boolPtr(false),
+		})
+		return out.ToJSON()
+	}
+
+	for i := range history.Changes {
+		tr := &history.Changes[i]
+		kind, past := describeTransition(tr)
+		if tr.At.Before(history.Now) {
+			kind = past
+		}
+		before, after := transitionWallClocks(tr)
+
+		out.AddItem(alfred.Item{
+			Title: fmt.Sprintf("%s %s (%s→%s)", before.Format("Jan 2, 2006"), strings.ToLower(kind[:1])+kind[1:], tr.OldAbbreviation, tr.NewAbbreviation),
+			Subtitle: fmt.Sprintf("%s → %s · %s → %s",
+				before.Format("3:04 PM MST"), after.Format("3:04 PM MST"),
+				domain.FormatUTCOffset(tr.OldOffset), domain.FormatUTCOffset(tr.NewOffset)),
+			Arg: tr.At.UTC().Format(time.RFC3339),
+			Variables: map[string]interface{}{
+				"timezone": history.Timezone.String(),
+			},
+		})
+	}
+	return out.ToJSON()
+}
+
+// FormatVersion formats one copyable Alfred item per data release
+func (f *AlfredFormatter) FormatVersion(report *usecases.VersionReport) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	for _, row := range [][2]string{
+		{"timein", report.Build},
+		{"host tzdata", report.Host},
+		{"embedded tzdata", report.Embedded},
+		{"tzf dataset", report.Tzf},
+	} {
+		version := versionOrUnknown(row[1])
+		out.AddItem(alfred.Item{
+			Title:    row[0] + " " + version,
+			Subtitle: "tzdata mode: " + report.Mode,
+			Arg:      version,
+		})
+	}
+	return out.ToJSON()
+}
+
+// FormatTzdataDiff formats one Alfred item per compared zone, differing zones first
+func (f *AlfredFormatter) FormatTzdataDiff(diff *usecases.TzdataDiff) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	zones := append([]usecases.ZoneRulesComparison(nil), diff.Zones...)
+	sort.SliceStable(zones, func(i, j int) bool {
+		return zones[i].Differs() && !zones[j].Differs()
+	})
+
+	sources := fmt.Sprintf("%s tzdata %s vs %s tzdata %s",
+		diff.Left, versionOrUnknown(diff.LeftVersion), diff.Right, versionOrUnknown(diff.RightVersion))
+	for i := range zones {
+		z := &zones[i]
+		title := z.Zone + ": same rules"
+		if z.Differs() {
+			title = z.Zone + ": rules differ"
+		}
+		out.AddItem(alfred.Item{
+			Title:    title,
+			Subtitle: sources + " · " + describeRulesComparison(diff, z),
+			Arg:      z.Zone,
+			Variables: map[string]interface{}{
+				"timezone": z.Zone,
+			},
+		})
+	}
+	return out.ToJSON()
+}
+
+// FormatZoneSearch formats one Alfred item per suggested zone that tab-completes to its
+// IANA name, so a partial or misspelled query can be finished without the geocoder
+func (f *AlfredFormatter) FormatZoneSearch(search *usecases.ZoneSearch) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	for _, s := range search.Zones {
+		_, offset := s.CurrentTime.Zone()
+		name := s.Timezone.String()
+		item := alfred.Item{
+			UID:          name,
+			Title:        name,
+			Subtitle:     fmt.Sprintf("%s · %s %s (%s)", suggestionPlace(s), s.CurrentTime.Format("Mon 3:04 PM"), s.Abbreviation, domain.FormatUTCOffset(offset)),
+			Arg:          name,
+			Autocomplete: name,
+			Variables: map[string]interface{}{
+				"timezone": name,
+			},
+		}
+		if s.Alias != "" {
+			item.Variables["alias"] = s.Alias
+		}
+		out.AddItem(item)
+	}
+	return out.ToJSON()
+}
+
+// FormatZoneList formats one Alfred item per zone, west to east, that tab-completes to
+// its IANA name
+func (f *AlfredFormatter) FormatZoneList(list *usecases.ZoneList) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 60}
+
+	if list.Count() == 0 {
+		out.AddItem(alfred.Item{
+			Title:    "No zones match",
+			Subtitle: "Try another offset such as +05:30 or a region such as Europe/",
+			Valid:    boolPtr(false),
+		})
+		return out.ToJSON()
+	}
+
+	for _, group := range list.Groups {
+		offset := domain.FormatUTCOffset(group.Offset)
+		for _, z := range group.Zones {
+			name := z.Timezone.String()
+			subtitle := fmt.Sprintf("%s · %s · %s %s", offset, zonePlace(z.Timezone), z.CurrentTime.Format("Mon 3:04 PM"), z.Abbreviation)
+			if z.ObservesDST {
+				subtitle += " · observes DST"
+			}
+			out.AddItem(alfred.Item{
+				UID:          name,
+				Title:        name,
+				Subtitle:     subtitle,
+				Arg:          name,
+				Autocomplete: name,
+				Variables: map[string]interface{}{
+					"timezone": name,
+					"offset":   offset,
+				},
+			})
+		}
+	}
+	return out.ToJSON()
+}
+
+// countdownRerunSeconds is how often Alfred re-runs a countdown so it ticks live
+const countdownRerunSeconds = 1
+
+// FormatCountdown formats the time left as an Alfred item that Alfred re-runs every second
+func (f *AlfredFormatter) FormatCountdown(countdown *usecases.Countdown) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Rerun = countdownRerunSeconds
+
+	target := countdown.Target
+	_, offset := target.CurrentTime.Zone()
+	title := fmt.Sprintf("%s until %s in %s", countdownDuration(countdown.Remaining), target.CurrentTime.Format("3:04 PM"), target.City)
+	subtitle := fmt.Sprintf("%s %s (%s)", target.CurrentTime.Format("Mon, Jan 2, 3:04 PM"), target.Abbreviation, domain.FormatUTCOffset(offset))
+	if rel := relativeToHome(f.home, target.CurrentTime); rel != "" {
+		subtitle += " · " + rel
+	}
+	if shifts := countdownShifts(countdown); shifts != "" {
+		subtitle += " · " + shifts
+	}
+
+	value := target.CurrentTime.Format(time.RFC3339)
+	out.AddItem(alfred.Item{
+		Title:    title,
+		Subtitle: subtitle,
+		Arg:      value,
+		Text:     &alfred.Text{Copy: value, LargeType: title},
+		Variables: map[string]interface{}{
+			"timezone": target.Timezone.String(),
+		},
+	})
+	return out.ToJSON()
+}
+
+// FormatParsedTimestamp formats one copyable Alfred item per zone and per machine-readable representation
+func (f *AlfredFormatter) FormatParsedTimestamp(parsed *usecases.ParsedTimestamp) ([]byte, error) {
+	out := alfred.NewScriptFilterOutput()
+	out.Cache = &alfred.CacheConfig{Seconds: 3600}
+
+	for _, info := range parsed.Zones {
+		_, offset := info.CurrentTime.Zone()
+		value := info.CurrentTime.Format(time.RFC3339Nano)
+		title := fmt.Sprintf("%s - %s", info.City, info.CurrentTime.Format("Mon, Jan 2, 2006, 3:04:05 PM"))
+		subtitle := fmt.Sprintf("%s (%s, %s) · from %s", info.Timezone.String(), info.Abbreviation, domain.FormatUTCOffset(offset), parsed.Timestamp.Format)
+		out.AddItem(alfred.Item{
+			UID:      info.Timezone.String(),
+			Title:    title,
+			Subtitle: subtitle,
+			Arg:      value,
+			Text:     &alfred.Text{Copy: value, LargeType: title},
+			Variables: map[string]interface{}{
+				"timezone": info.Timezone.String(),
+			},
+		})
+	}
+
+	for _, r := range timestampRepresentations(parsed.Timestamp.Time) {
+		out.AddItem(alfred.Item{
+			UID:      r.Name,
+			Title:    r.Value,
+			Subtitle: r.Name,
+			Arg:      r.Value,
+			Text:     &alfred.Text{Copy: r.Value, LargeType: r.Value},
+		})
+	}
+	return out.ToJSON()
+}
+
 // FormatError formats error messages for Alfred
 func (f *AlfredFormatter) FormatError(message string) ([]byte, error) {
 	out := alfred.NewScriptFilterOutput()
@@ -93,4 +567,19 @@ func (f *AlfredFormatter) FormatError(message string) ([]byte, error) {
 
 func boolPtr(b bool) *bool {
 	return &b
-}
\ No newline at end of file
+}
+
+// dayPeriodIcons are the workflow's icons for each period, relative to the workflow folder
+var dayPeriodIcons = map[domain.DayPeriod]string{
+	domain.PeriodWorking:      "icons/working.png",
+	domain.PeriodEarlyMorning: "icons/early-morning.png",
+	domain.PeriodEvening:      "icons/evening.png",
+	domain.PeriodNight:        "icons/night.png",
+	domain.PeriodWeekend:      "icons/weekend.png",
+	domain.PeriodHoliday:      "icons/holiday.png",
+}
+
+// dayPeriodIcon returns the Alfred icon for what people in tz are probably doing at t
+func dayPeriodIcon(schedule domain.Schedule, tz *domain.Timezone, t time.Time) *alfred.Icon {
+	return &alfred.Icon{Path: dayPeriodIcons[schedule.PeriodIn(tz, t)]}
+}
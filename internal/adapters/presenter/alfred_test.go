@@ -2,9 +2,13 @@ package presenter
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 func TestAlfredFormatter_ShouldFormatValidTimezoneInfoWithCache(t *testing.T) {
@@ -51,13 +55,116 @@ func TestAlfredFormatter_ShouldFormatValidTimezoneInfoWithCache(t *testing.T) {
 	}
 }
 
+func TestAlfredFormatter_ShouldShowCanonicalNameAndRecordAlias(t *testing.T) {
+	// Given an Alfred formatter and a zone given by its old name
+	formatter := NewAlfredFormatter()
+	timezone, _ := domain.NewTimezone("Asia/Calcutta")
+
+	// When formatting timezone info
+	output, err := formatter.FormatTimezoneInfo(timezone, "Calcutta", false)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then the current name should be the title and the old one kept as a variable
+	var result struct {
+		Items []struct {
+			Title     string            `json:"title"`
+			Subtitle  string            `json:"subtitle"`
+			Variables map[string]string `json:"variables"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	item := result.Items[0]
+	if item.Title != "Asia/Kolkata" || item.Variables["alias"] != "Asia/Calcutta" {
+		t.Errorf("Expected Asia/Kolkata with alias Asia/Calcutta, got %q and %v", item.Title, item.Variables)
+	}
+	if item.Subtitle != "Calcutta · alias Asia/Calcutta" {
+		t.Errorf("Expected the alias in the subtitle, got %q", item.Subtitle)
+	}
+}
+
+func TestAlfredFormatter_ShouldNameTheGeocoderThatAnswered(t *testing.T) {
+	// Given a timezone geocoded by the offline gazetteer
+	formatter := NewAlfredFormatter()
+	timezone, _ := domain.NewTimezone("Asia/Kolkata")
+	timezone.Place = &domain.Location{Name: "Mumbai", Latitude: 19.07283, Longitude: 72.88261, Source: "offline"}
+
+	// When formatting a fresh lookup
+	output, err := formatter.FormatTimezoneInfo(timezone, "Bombay", false)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then the subtitle and variables should say which geocoder answered
+	var result struct {
+		Items []struct {
+			Subtitle  string            `json:"subtitle"`
+			Variables map[string]string `json:"variables"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	item := result.Items[0]
+	if item.Subtitle != "Bombay · via offline" || item.Variables["geocoder"] != "offline" {
+		t.Errorf("Expected the geocoder to be named, got %q and %v", item.Subtitle, item.Variables)
+	}
+}
+
+func TestAlfredFormatter_ShouldOfferOneItemPerCandidateTimezone(t *testing.T) {
+	// Given two places called Portland in different timezones
+	formatter := NewAlfredFormatter()
+	oregon, _ := domain.NewTimezone("America/Los_Angeles")
+	oregon.Place = &domain.Location{Name: "Portland", Latitude: 45.52345, Longitude: -122.67621, DisplayName: "Portland, Oregon", Source: "online"}
+	maine, _ := domain.NewTimezone("America/New_York")
+	maine.Place = &domain.Location{Name: "Portland", Latitude: 43.66147, Longitude: -70.25533, DisplayName: "Portland, Maine", Source: "online"}
+
+	// When formatting them as candidates
+	output, err := formatter.FormatTimezoneCandidates("Portland", []*domain.Timezone{oregon, maine})
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then each should be an item carrying the choice to remember
+	var result struct {
+		Items []struct {
+			Title     string            `json:"title"`
+			Subtitle  string            `json:"subtitle"`
+			Arg       string            `json:"arg"`
+			Variables map[string]string `json:"variables"`
+		} `json:"items"`
+		Cache interface{} `json:"cache"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if len(result.Items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(result.Items))
+	}
+	item := result.Items[1]
+	if item.Title != "America/New_York" || item.Arg != "America/New_York" || item.Subtitle != "Portland, Maine · via online" {
+		t.Errorf("Unexpected item %+v", item)
+	}
+	if item.Variables["choice"] != "America/New_York 43.661470,-70.255330 Portland, Maine" || item.Variables["city"] != "Portland" {
+		t.Errorf("Unexpected variables %v", item.Variables)
+	}
+
+	// And Alfred should not cache the list, so a remembered choice shows up at once
+	if result.Cache != nil {
+		t.Errorf("Expected no Alfred cache, got %v", result.Cache)
+	}
+}
+
 func TestAlfredFormatter_ShouldFormatTimeInfoWithAbbreviation(t *testing.T) {
 	// Given an Alfred formatter and a timezone
 	formatter := NewAlfredFormatter()
 	timezone, _ := domain.NewTimezone("America/New_York")
 	
 	// When formatting time info
-	output, err := formatter.FormatTimeInfo(timezone)
+	output, err := formatter.FormatTimeInfo(timezone, time.Now())
 	
 	// Then it should produce valid Alfred JSON with time
 	if err != nil {
@@ -91,6 +198,33 @@ func TestAlfredFormatter_ShouldFormatTimeInfoWithAbbreviation(t *testing.T) {
 	}
 }
 
+func TestAlfredFormatter_ShouldUseExemplarCityInSubtitle(t *testing.T) {
+	// Given zones whose second path component is a region, not a city
+	formatter := NewAlfredFormatter()
+	tests := map[string]string{
+		"America/Argentina/Buenos_Aires": "Current time in Buenos Aires",
+		"Etc/GMT+5":                      "Current time in UTC-05:00",
+	}
+
+	for name, expected := range tests {
+		tz, _ := domain.NewTimezone(name)
+
+		// When formatting time info
+		output, err := formatter.FormatTimeInfo(tz, time.Now())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Then the subtitle should name the exemplar city
+		var result map[string]interface{}
+		json.Unmarshal(output, &result)
+		subtitle := result["items"].([]interface{})[0].(map[string]interface{})["subtitle"].(string)
+		if !contains(subtitle, expected) {
+			t.Errorf("expected subtitle for %s to contain %q, got %q", name, expected, subtitle)
+		}
+	}
+}
+
 func TestAlfredFormatter_ShouldFormatErrorsAsInvalidItems(t *testing.T) {
 	// Given an Alfred formatter and an error message
 	formatter := NewAlfredFormatter()
@@ -140,4 +274,429 @@ func indexOf(s, substr string) int {
 		}
 	}
 	return -1
-}
\ No newline at end of file
+}
+
+func TestAlfredFormatter_ShouldFormatConversionWithTargetAsTitle(t *testing.T) {
+	// Given an Alfred formatter and a conversion from London to Tokyo
+	formatter := NewAlfredFormatter()
+	conversion := newTestConversion(t)
+
+	// When formatting the conversion
+	output, err := formatter.FormatConversion(conversion)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then the title should show the target time and the subtitle the source
+	item := result["items"].([]interface{})[0].(map[string]interface{})
+	if item["title"] != "Wed, Jun 10, 11:00 PM in Tokyo (JST)" {
+		t.Errorf("Unexpected title '%v'", item["title"])
+	}
+	if item["subtitle"] != "Wed, Jun 10, 3:00 PM in London (BST)" {
+		t.Errorf("Unexpected subtitle '%v'", item["subtitle"])
+	}
+}
+
+func TestAlfredFormatter_ShouldFormatWorldClockAsOneItemPerZone(t *testing.T) {
+	// Given an Alfred formatter and two zones at the same instant
+	formatter := NewAlfredFormatter()
+	conversion := newTestConversion(t)
+	infos := []*usecases.TimezoneInfo{conversion.Source, conversion.Target}
+
+	// When formatting the world clock
+	output, err := formatter.FormatWorldClock(infos)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then there should be one item per zone with its offset
+	items := result["items"].([]interface{})
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items))
+	}
+	item := items[1].(map[string]interface{})
+	if item["title"] != "Tokyo - Wed, Jun 10, 11:00 PM" {
+		t.Errorf("Unexpected title '%v'", item["title"])
+	}
+	if item["subtitle"] != "Asia/Tokyo (JST, UTC+09:00) · 23:00 – probably asleep · 🌌 dark, sunrise 04:24" {
+		t.Errorf("Unexpected subtitle '%v'", item["subtitle"])
+	}
+}
+
+func TestAlfredFormatter_ShouldLetTheWorkflowRememberAPipedCandidate(t *testing.T) {
+	// Given the candidates geotz --coords found for "Portland"
+	formatter := NewAlfredFormatter().WithCandidatesFor(" Portland ")
+	at := time.Date(2026, 6, 10, 14, 0, 0, 0, time.UTC)
+	var infos []*usecases.TimezoneInfo
+	for _, placed := range []string{
+		"America/Los_Angeles 45.523450,-122.676210 Portland, Oregon, United States",
+		"America/New_York 43.661470,-70.255330 Portland, Maine, United States",
+	} {
+		tz, err := domain.NewPlacedTimezone(placed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		loc, _ := tz.Location()
+		infos = append(infos, &usecases.TimezoneInfo{Timezone: tz, CurrentTime: at.In(loc), City: tz.Place.DisplayName})
+	}
+
+	// When formatting them as a world clock
+	output, err := formatter.FormatWorldClock(infos)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result struct {
+		Items []struct {
+			Title     string            `json:"title"`
+			Variables map[string]string `json:"variables"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then each item should name its place and carry what geotz --choose remembers
+	item := result.Items[1]
+	if !contains(item.Title, "Portland, Maine, United States - ") {
+		t.Errorf("Unexpected title %q", item.Title)
+	}
+	if item.Variables["choice"] != "America/New_York 43.661470,-70.255330 Portland, Maine, United States" || item.Variables["city"] != "Portland" {
+		t.Errorf("Unexpected variables %v", item.Variables)
+	}
+
+	// But a world clock of zones the user listed should not offer to remember anything
+	output, _ = NewAlfredFormatter().FormatWorldClock(infos)
+	result.Items = nil
+	json.Unmarshal(output, &result)
+	if _, ok := result.Items[0].Variables["choice"]; ok {
+		t.Errorf("Expected no choice variable, got %v", result.Items[0].Variables)
+	}
+}
+
+func TestAlfredFormatter_ShouldFormatOverlapAsOneItemPerSlot(t *testing.T) {
+	// Given an Alfred formatter and an overlap between London and New York
+	formatter := NewAlfredFormatter()
+	overlap := newTestOverlap(t)
+
+	// When formatting the overlap
+	output, err := formatter.FormatOverlap(overlap)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then the slot should be shown in the first participant's time with the others below
+	item := result["items"].([]interface{})[0].(map[string]interface{})
+	if item["title"] != "Wed, Jun 10, 2:00 PM–5:00 PM London (3h)" {
+		t.Errorf("Unexpected title '%v'", item["title"])
+	}
+	if item["subtitle"] != "New York 9:00 AM–12:00 PM" {
+		t.Errorf("Unexpected subtitle '%v'", item["subtitle"])
+	}
+}
+
+func TestAlfredFormatter_ShouldShowOffsetRelativeToHomeInSubtitle(t *testing.T) {
+	// Given an Alfred formatter with a home timezone in Los Angeles
+	home, _ := domain.NewTimezone("America/Los_Angeles")
+	formatter := NewAlfredFormatter().WithHomeTimezone(home)
+	conversion := newTestConversion(t)
+
+	// When formatting a world clock entry for Tokyo at 11pm
+	output, err := formatter.FormatWorldClock([]*usecases.TimezoneInfo{conversion.Target})
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then the subtitle should say Tokyo is 16 hours ahead on the same day
+	item := result["items"].([]interface{})[0].(map[string]interface{})
+	if item["subtitle"] != "Asia/Tokyo (JST, UTC+09:00) · +16h, same day · 23:00 – probably asleep · 🌌 dark, sunrise 04:24" {
+		t.Errorf("Unexpected subtitle '%v'", item["subtitle"])
+	}
+}
+
+func TestAlfredFormatter_ShouldFormatTransitionsRelativeToNow(t *testing.T) {
+	// Given an Alfred formatter and Berlin's 2026 transitions seen from mid-October
+	formatter := NewAlfredFormatter()
+	report := newTestTransitionReport(t)
+
+	// When formatting the report
+	output, err := formatter.FormatTransitions(report)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then past and upcoming transitions should be phrased accordingly
+	items := result["items"].([]interface{})
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items))
+	}
+	if title := items[0].(map[string]interface{})["title"]; title != "DST started 201 days ago (CET→CEST)" {
+		t.Errorf("Unexpected title '%v'", title)
+	}
+	if title := items[1].(map[string]interface{})["title"]; title != "DST ends in 8 days (CEST→CET)" {
+		t.Errorf("Unexpected title '%v'", title)
+	}
+}
+
+func TestTransitionNotice_ShouldOnlyAnnounceNearbyTransitions(t *testing.T) {
+	tz, _ := domain.NewTimezone("Europe/Berlin")
+
+	tests := []struct {
+		now      time.Time
+		expected string
+	}{
+		{time.Date(2026, time.October, 16, 1, 0, 0, 0, time.UTC), "DST ends in 9 days (CEST→CET)"},
+		{time.Date(2026, time.October, 24, 12, 0, 0, 0, time.UTC), "DST ends in 13 hours (CEST→CET)"},
+		{time.Date(2026, time.October, 27, 1, 0, 0, 0, time.UTC), "DST ended 2 days ago (CEST→CET)"},
+		{time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC), ""},
+	}
+
+	for _, test := range tests {
+		if got := transitionNotice(tz, test.now); got != test.expected {
+			t.Errorf("at %v, expected %q, got %q", test.now, test.expected, got)
+		}
+	}
+}
+
+func TestAlfredFormatter_ShouldFormatParsedTimestampAsCopyableItems(t *testing.T) {
+	// Given an Alfred formatter and a timestamp shown in London and Tokyo
+	formatter := NewAlfredFormatter()
+	parsed := newTestParsedTimestamp(t)
+
+	// When formatting the parsed timestamp
+	output, err := formatter.FormatParsedTimestamp(parsed)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then there should be an item per zone followed by one per representation
+	items := result["items"].([]interface{})
+	if len(items) != 6 {
+		t.Fatalf("Expected 6 items, got %d", len(items))
+	}
+	tokyo := items[1].(map[string]interface{})
+	if tokyo["arg"] != "2026-06-10T23:00:00+09:00" {
+		t.Errorf("Unexpected arg '%v'", tokyo["arg"])
+	}
+	if tokyo["subtitle"] != "Asia/Tokyo (JST, UTC+09:00) · from Unix seconds" {
+		t.Errorf("Unexpected subtitle '%v'", tokyo["subtitle"])
+	}
+
+	// And every representation should be copyable
+	millis := items[3].(map[string]interface{})
+	text := millis["text"].(map[string]interface{})
+	if millis["title"] != "1781100000000" || text["copy"] != "1781100000000" || millis["subtitle"] != "Unix milliseconds" {
+		t.Errorf("Unexpected representation item %v", millis)
+	}
+}
+
+func TestAlfredFormatter_ShouldHintDayPeriodWithLocalWeekendAndSchedule(t *testing.T) {
+	// Given an Alfred formatter with an early schedule
+	work, _ := domain.ParseWorkingHours("07:00-15:00")
+	formatter := NewAlfredFormatter().WithSchedule(domain.Schedule{Work: work, Sleep: domain.DefaultSchedule.Sleep})
+	riyadh, _ := domain.NewTimezone("Asia/Riyadh")
+	london, _ := domain.NewTimezone("Europe/London")
+
+	// When formatting Friday 12 June 2026 at 15:00 UTC
+	at := time.Date(2026, time.June, 12, 15, 0, 0, 0, time.UTC)
+	tests := map[*domain.Timezone]struct{ hint, icon string }{
+		riyadh: {"18:00 – weekend", "icons/weekend.png"},
+		london: {"16:00 – evening", "icons/evening.png"},
+	}
+
+	for tz, expected := range tests {
+		output, err := formatter.FormatTimeInfo(tz, at)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Then the subtitle and icon should follow the local weekend and the configured hours
+		var result map[string]interface{}
+		json.Unmarshal(output, &result)
+		item := result["items"].([]interface{})[0].(map[string]interface{})
+		if subtitle := item["subtitle"].(string); !contains(subtitle, expected.hint) {
+			t.Errorf("expected subtitle for %s to contain %q, got %q", tz, expected.hint, subtitle)
+		}
+		if icon, _ := item["icon"].(map[string]interface{}); icon == nil || icon["path"] != expected.icon {
+			t.Errorf("expected icon %s for %s, got %v", expected.icon, tz, item["icon"])
+		}
+	}
+}
+
+func TestAlfredFormatter_DayPeriodIconsShouldShipWithTheWorkflow(t *testing.T) {
+	for period, path := range dayPeriodIcons {
+		if _, err := os.Stat(filepath.Join("..", "..", "..", "workflow", path)); err != nil {
+			t.Errorf("missing icon for %s: %v", period, err)
+		}
+	}
+}
+
+func TestAlfredFormatter_ShouldFlagPublicHolidays(t *testing.T) {
+	// Given an Alfred formatter and Tokyo at noon on Showa Day
+	formatter := NewAlfredFormatter()
+	tz, _ := domain.NewTimezone("Asia/Tokyo")
+	at := time.Date(2026, time.April, 29, 3, 0, 0, 0, time.UTC)
+
+	// When formatting time info at that instant
+	output, err := formatter.FormatTimeInfo(tz, at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the subtitle should flag the holiday
+	var result map[string]interface{}
+	json.Unmarshal(output, &result)
+	subtitle := result["items"].([]interface{})[0].(map[string]interface{})["subtitle"].(string)
+	for _, expected := range []string{"12:00 – public holiday", "Public holiday in Japan today (Showa Day)"} {
+		if !contains(subtitle, expected) {
+			t.Errorf("expected subtitle to contain %q, got %q", expected, subtitle)
+		}
+	}
+}
+
+func TestAlfredFormatter_ShouldShowDaylightAtGeocodedPlace(t *testing.T) {
+	// Given Tokyo resolved for a place in Sapporo, at noon local time
+	formatter := NewAlfredFormatter()
+	tz, _ := domain.NewPlacedTimezone("Asia/Tokyo 43.0618,141.3545")
+	at := time.Date(2026, time.June, 10, 3, 0, 0, 0, time.UTC)
+
+	// When formatting time info at that instant
+	output, err := formatter.FormatTimeInfo(tz, at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the subtitle should show daylight until Sapporo's sunset, not Tokyo's
+	var result map[string]interface{}
+	json.Unmarshal(output, &result)
+	item := result["items"].([]interface{})[0].(map[string]interface{})
+	if subtitle := item["subtitle"].(string); !contains(subtitle, "☀️ daylight, sunset 19:1") {
+		t.Errorf("expected daylight until about 19:15, got %q", subtitle)
+	}
+
+	// And holding ⌥ should list the day's sun events
+	alt := item["mods"].(map[string]interface{})["alt"].(map[string]interface{})
+	for _, expected := range []string{"Sunrise 03:5", "Solar noon 11:3", "Civil twilight"} {
+		if !contains(alt["subtitle"].(string), expected) {
+			t.Errorf("expected alt subtitle to contain %q, got %q", expected, alt["subtitle"])
+		}
+	}
+}
+
+func TestAlfredFormatter_ShouldAutocompleteSuggestedZones(t *testing.T) {
+	// Given a zone suggested for a search by its old name
+	formatter := NewAlfredFormatter()
+	uc := usecases.NewZoneSearchUseCase(formatter).WithClock(usecases.FixedClock(time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)))
+
+	// When formatting the suggestions
+	output, err := uc.GetZoneSuggestions("calcutta")
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then the item should tab-complete to the current IANA name
+	var result struct {
+		Items []struct {
+			Title        string `json:"title"`
+			Subtitle     string `json:"subtitle"`
+			Arg          string `json:"arg"`
+			Autocomplete string `json:"autocomplete"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	item := result.Items[0]
+	if item.Title != "Asia/Kolkata" || item.Autocomplete != "Asia/Kolkata" || item.Arg != "Asia/Kolkata" {
+		t.Errorf("Expected an Asia/Kolkata item completing to its name, got %+v", item)
+	}
+	if item.Subtitle != "Kolkata, India (alias Asia/Calcutta) · Thu 5:30 PM IST (UTC+05:30)" {
+		t.Errorf("Unexpected subtitle %q", item.Subtitle)
+	}
+}
+
+func TestAlfredFormatter_ShouldRerunCountdowns(t *testing.T) {
+	// Given a countdown to 9am in Tokyo
+	formatter := NewAlfredFormatter()
+	tokyo, _ := domain.NewTimezone("Asia/Tokyo")
+	loc, _ := tokyo.Location()
+	target := time.Date(2026, time.June, 11, 9, 0, 0, 0, loc)
+	countdown := &usecases.Countdown{
+		Target:    &usecases.TimezoneInfo{Timezone: tokyo, CurrentTime: target, City: "Tokyo", Abbreviation: "JST"},
+		Remaining: 2*time.Hour + 3*time.Minute + 4*time.Second,
+	}
+
+	// When formatting it for Alfred
+	output, err := formatter.FormatCountdown(countdown)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then Alfred should re-run it every second to tick live
+	var result struct {
+		Rerun float64 `json:"rerun"`
+		Items []struct {
+			Title string `json:"title"`
+			Arg   string `json:"arg"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if result.Rerun != 1 {
+		t.Errorf("Expected rerun 1, got %v", result.Rerun)
+	}
+	if result.Items[0].Title != "2h 3m 4s until 9:00 AM in Tokyo" || result.Items[0].Arg != "2026-06-11T09:00:00+09:00" {
+		t.Errorf("Unexpected item %+v", result.Items[0])
+	}
+}
+
+func TestAlfredFormatter_ShouldNotCallAFixedInstantCurrent(t *testing.T) {
+	// Given an Alfred formatter showing a fixed instant, as with --at
+	formatter := NewAlfredFormatter().WithFixedTime(true)
+	tz, _ := domain.NewTimezone("Europe/London")
+	at := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// When formatting time info
+	output, err := formatter.FormatTimeInfo(tz, at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the subtitle should name the instant's own abbreviation without saying "Current"
+	var result map[string]interface{}
+	json.Unmarshal(output, &result)
+	subtitle := result["items"].([]interface{})[0].(map[string]interface{})["subtitle"].(string)
+	if !contains(subtitle, "Time in London (BST)") {
+		t.Errorf("expected subtitle to start with %q, got %q", "Time in London (BST)", subtitle)
+	}
+}
@@ -0,0 +1,322 @@
+package presenter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// localSlot returns a slot's bounds in the participant's timezone
+func localSlot(slot domain.TimeSlot, p *domain.Participant) (time.Time, time.Time) {
+	loc, err := p.Timezone.Location()
+	if err != nil {
+		return slot.Start, slot.End
+	}
+	return slot.Start.In(loc), slot.End.In(loc)
+}
+
+// participantSummary lists participants with their working hours
+func participantSummary(participants []*domain.Participant) string {
+	parts := make([]string, 0, len(participants))
+	for _, p := range participants {
+		parts = append(parts, fmt.Sprintf("%s %s", p.Name, p.Hours))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatDuration renders durations compactly, e.g. "1h30m" or "45m"
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh%02dm", h, m)
+	}
+}
+
+// countdownDuration renders the time left to the second, e.g. "2d 4h 13m 5s" or "45m 0s"
+func countdownDuration(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	days, hours := int(d/(24*time.Hour)), int(d/time.Hour)%24
+	minutes, seconds := int(d/time.Minute)%60, int(d/time.Second)%60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh %dm %ds", days, hours, minutes, seconds)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm %ds", hours, minutes, seconds)
+	default:
+		return fmt.Sprintf("%dm %ds", minutes, seconds)
+	}
+}
+
+// countdownShifts names the offset changes a countdown spans, e.g.
+// "DST ends Sun 25 Oct (BST→GMT)", or returns "" when there are none
+func countdownShifts(countdown *usecases.Countdown) string {
+	loc := countdown.Target.CurrentTime.Location()
+	parts := make([]string, 0, len(countdown.Transitions))
+	for i := range countdown.Transitions {
+		tr := &countdown.Transitions[i]
+		upcoming, _ := describeTransition(tr)
+		parts = append(parts, fmt.Sprintf("%s %s (%s→%s)", upcoming, tr.At.In(loc).Format("Mon 02 Jan"), tr.OldAbbreviation, tr.NewAbbreviation))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// relativeToHome describes t's timezone relative to home, or "" without a home timezone
+func relativeToHome(home *domain.Timezone, t time.Time) string {
+	if home == nil {
+		return ""
+	}
+	homeLoc, err := home.Location()
+	if err != nil {
+		return ""
+	}
+	return domain.NewRelativeOffset(t, homeLoc, t.Location()).String()
+}
+
+// transitionNoticeWindow is how far ahead an upcoming transition is announced,
+// and transitionRecentWindow how long a past one keeps being mentioned
+const (
+	transitionNoticeWindow = 30 * 24 * time.Hour
+	transitionRecentWindow = 7 * 24 * time.Hour
+)
+
+// describeTransition names the kind of offset change, e.g. "DST ends" and "DST ended"
+func describeTransition(tr *domain.Transition) (upcoming, past string) {
+	switch {
+	case tr.StartsDST():
+		return "DST starts", "DST started"
+	case tr.EndsDST():
+		return "DST ends", "DST ended"
+	case tr.OldOffset == tr.NewOffset && tr.OldAbbreviation != tr.NewAbbreviation:
+		return "Abbreviation changes", "Abbreviation changed"
+	case tr.OldOffset == tr.NewOffset:
+		return "DST flag changes", "DST flag changed"
+	default:
+		return "UTC offset changes", "UTC offset changed"
+	}
+}
+
+// transitionNotice announces a nearby offset transition, e.g. "DST ends in 9 days (CEST→CET)",
+// or returns "" when none is close to now
+func transitionNotice(tz *domain.Timezone, now time.Time) string {
+	if next, err := tz.NextTransition(now); err == nil && next != nil && next.At.Sub(now) <= transitionNoticeWindow {
+		upcoming, _ := describeTransition(next)
+		return fmt.Sprintf("%s %s (%s→%s)", upcoming, relativeTime(next.At.Sub(now)), next.OldAbbreviation, next.NewAbbreviation)
+	}
+	if prev, err := tz.PreviousTransition(now); err == nil && prev != nil && now.Sub(prev.At) <= transitionRecentWindow {
+		_, past := describeTransition(prev)
+		return fmt.Sprintf("%s %s (%s→%s)", past, relativeTime(prev.At.Sub(now)), prev.OldAbbreviation, prev.NewAbbreviation)
+	}
+	return ""
+}
+
+// describePeriod names the kind of time a zone period keeps, e.g. "standard time"
+func describePeriod(period *domain.ZonePeriod) string {
+	if period.IsDST {
+		return "daylight saving time"
+	}
+	return "standard time"
+}
+
+// periodWallClocks returns the wall clock at which a zone period starts and ends, both
+// read at the period's own offset; either is zero when the period is unbounded
+func periodWallClocks(period *domain.ZonePeriod) (time.Time, time.Time) {
+	zone := time.FixedZone(period.Abbreviation, period.Offset)
+	var start, end time.Time
+	if !period.Start.IsZero() {
+		start = period.Start.In(zone)
+	}
+	if !period.End.IsZero() {
+		end = period.End.In(zone)
+	}
+	return start, end
+}
+
+// relativeTime renders a signed duration as "in 9 days", "in 5 hours" or "2 days ago"
+func relativeTime(d time.Duration) string {
+	past := d < 0
+	if past {
+		d = -d
+	}
+
+	var amount string
+	switch {
+	case d < time.Hour:
+		amount = plural(int(d.Minutes()), "minute")
+	case d < 48*time.Hour:
+		amount = plural(int(d.Hours()), "hour")
+	default:
+		amount = plural(int(d.Hours()/24), "day")
+	}
+
+	if past {
+		return amount + " ago"
+	}
+	return "in " + amount
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// transitionWallClocks returns the local wall clock just before and at a transition
+func transitionWallClocks(tr *domain.Transition) (time.Time, time.Time) {
+	before := tr.At.In(time.FixedZone(tr.OldAbbreviation, tr.OldOffset))
+	after := tr.At.In(time.FixedZone(tr.NewAbbreviation, tr.NewOffset))
+	return before, after
+}
+
+// representation is one machine-readable rendering of an instant
+type representation struct {
+	Name  string
+	Value string
+}
+
+// rfc1123GMT is RFC 1123 as HTTP and mail headers write UTC
+const rfc1123GMT = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// timestampRepresentations renders t in the formats ParseTimestamp understands
+func timestampRepresentations(t time.Time) []representation {
+	utc := t.UTC()
+	return []representation{
+		{"Unix seconds", strconv.FormatInt(utc.Unix(), 10)},
+		{"Unix milliseconds", strconv.FormatInt(utc.UnixMilli(), 10)},
+		{"RFC 3339", utc.Format(time.RFC3339Nano)},
+		{"RFC 1123", utc.Format(rfc1123GMT)},
+	}
+}
+
+// dayPeriodHint describes what people in tz are probably doing at t, e.g. "22:40 – probably asleep"
+func dayPeriodHint(schedule domain.Schedule, tz *domain.Timezone, t time.Time) string {
+	period := schedule.PeriodIn(tz, t)
+	return fmt.Sprintf("%s – %s", t.Format("15:04"), period)
+}
+
+// dayPeriodLabel names the period at t, with the holiday's name on public holidays,
+// e.g. "public holiday (Showa Day)"
+func dayPeriodLabel(schedule domain.Schedule, tz *domain.Timezone, t time.Time) string {
+	period := schedule.PeriodIn(tz, t)
+	if holiday, ok := tz.Holiday(t); ok && period == domain.PeriodHoliday {
+		return fmt.Sprintf("%s (%s)", period, holiday.Name)
+	}
+	return period.String()
+}
+
+// holidayNotice flags a public holiday on t's local date, e.g. "Public holiday in Japan today (Showa Day)"
+func holidayNotice(tz *domain.Timezone, t time.Time) string {
+	holiday, ok := tz.Holiday(t)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("Public holiday in %s today (%s)", domain.CountryName(holiday.CountryCode), holiday.Name)
+}
+
+// sunIcons mark each sun phase in Alfred subtitles
+var sunIcons = map[domain.SunPhase]string{
+	domain.SunDaylight: "☀️",
+	domain.SunDawn:     "🌅",
+	domain.SunDusk:     "🌇",
+	domain.SunDark:     "🌌",
+}
+
+// sunHint describes the daylight in tz at t, e.g. "☀️ daylight, sunset 18:42", or ""
+// when the zone has no known coordinates
+func sunHint(tz *domain.Timezone, t time.Time) string {
+	place, ok := tz.Coordinates()
+	if !ok {
+		return ""
+	}
+	status := place.SunAt(t)
+	hint := fmt.Sprintf("%s %s", sunIcons[status.Phase], status.Phase)
+	switch {
+	case !status.Next.IsZero():
+		return fmt.Sprintf("%s, %s %s", hint, status.NextEvent, status.Next.In(t.Location()).Format("15:04"))
+	case status.Phase == domain.SunDaylight:
+		return sunIcons[status.Phase] + " midnight sun"
+	default:
+		return hint + ", polar night"
+	}
+}
+
+// sunDetail lists the day's sun events in tz at t, e.g.
+// "Sunrise 04:52 · Solar noon 11:38 · Sunset 18:25 · Civil twilight 04:24–18:52"
+func sunDetail(tz *domain.Timezone, t time.Time) string {
+	place, ok := tz.Coordinates()
+	if !ok {
+		return ""
+	}
+	times := place.SunTimes(t)
+	var parts []string
+	switch {
+	case times.PolarDay:
+		parts = append(parts, "Midnight sun")
+	case times.PolarNight:
+		parts = append(parts, "Polar night")
+	default:
+		parts = append(parts, "Sunrise "+times.Sunrise.Format("15:04"))
+	}
+	parts = append(parts, "Solar noon "+times.SolarNoon.Format("15:04"))
+	if !times.Sunset.IsZero() {
+		parts = append(parts, "Sunset "+times.Sunset.Format("15:04"))
+	}
+	if !times.CivilDawn.IsZero() {
+		parts = append(parts, fmt.Sprintf("Civil twilight %s–%s", times.CivilDawn.Format("15:04"), times.CivilDusk.Format("15:04")))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// versionOrUnknown names a data release, or says it could not be found
+func versionOrUnknown(version string) string {
+	if version == "" {
+		return "unknown"
+	}
+	return version
+}
+
+// describeRulesComparison says whether two tzdata copies agree on a zone, e.g.
+// "differs from 2011-03-27 00:00 UTC: host UTC+04:00 (MSK), embedded UTC+03:00 (MSK)"
+func describeRulesComparison(diff *usecases.TzdataDiff, z *usecases.ZoneRulesComparison) string {
+	switch {
+	case z.Missing != "":
+		return "missing from " + z.Missing
+	case z.Difference == nil:
+		return "same"
+	}
+	d := z.Difference
+	return fmt.Sprintf("differs from %s: %s %s (%s), %s %s (%s)",
+		d.At.UTC().Format("2006-01-02 15:04 MST"),
+		diff.Left, domain.FormatUTCOffset(d.Left.Offset), d.Left.Abbreviation,
+		diff.Right, domain.FormatUTCOffset(d.Right.Offset), d.Right.Abbreviation)
+}
+
+// zonePlace names where a zone is, e.g. "Kolkata, India", or just its city for zones
+// such as UTC that belong to no country
+func zonePlace(tz *domain.Timezone) string {
+	place := tz.City()
+	if code := tz.CountryCode(); code != "" {
+		place += ", " + domain.CountryName(code)
+	}
+	return place
+}
+
+// suggestionPlace names where a suggested zone is, noting the link the query
+// matched, e.g. "Kolkata, India (alias Asia/Calcutta)"
+func suggestionPlace(s *usecases.ZoneSuggestion) string {
+	place := zonePlace(s.Timezone)
+	if s.Alias != "" {
+		place += " (alias " + s.Alias + ")"
+	}
+	return place
+}
@@ -0,0 +1,143 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+// JSONFormatter formats output as machine-readable JSON
+type JSONFormatter struct{}
+
+// NewJSONFormatter creates a new JSONFormatter
+func NewJSONFormatter() *JSONFormatter {
+	return &JSONFormatter{}
+}
+
+type jsonParticipant struct {
+	Name     string `json:"name"`
+	Timezone string `json:"timezone"`
+	Hours    string `json:"working_hours"`
+}
+
+type jsonLocalSlot struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type jsonSlot struct {
+	Start           string                   `json:"start"`
+	End             string                   `json:"end"`
+	DurationMinutes int                      `json:"duration_minutes"`
+	Local           map[string]jsonLocalSlot `json:"local"`
+}
+
+// FormatOverlap formats shared meeting slots as JSON
+func (f *JSONFormatter) FormatOverlap(overlap *usecases.Overlap) ([]byte, error) {
+	out := struct {
+		From         string            `json:"from"`
+		To           string            `json:"to"`
+		Participants []jsonParticipant `json:"participants"`
+		Slots        []jsonSlot        `json:"slots"`
+	}{
+		From:         overlap.From.Format(time.RFC3339),
+		To:           overlap.To.Format(time.RFC3339),
+		Participants: make([]jsonParticipant, 0, len(overlap.Participants)),
+		Slots:        make([]jsonSlot, 0, len(overlap.Slots)),
+	}
+
+	for _, p := range overlap.Participants {
+		out.Participants = append(out.Participants, jsonParticipant{
+			Name:     p.Name,
+			Timezone: p.Timezone.String(),
+			Hours:    p.Hours.String(),
+		})
+	}
+
+	for _, slot := range overlap.Slots {
+		js := jsonSlot{
+			Start:           slot.Start.UTC().Format(time.RFC3339),
+			End:             slot.End.UTC().Format(time.RFC3339),
+			DurationMinutes: int(slot.Duration().Minutes()),
+			Local:           make(map[string]jsonLocalSlot, len(overlap.Participants)),
+		}
+		for _, p := range overlap.Participants {
+			s, e := localSlot(slot, p)
+			js.Local[p.Name] = jsonLocalSlot{Start: s.Format(time.RFC3339), End: e.Format(time.RFC3339)}
+		}
+		out.Slots = append(out.Slots, js)
+	}
+
+	return marshalJSON(out)
+}
+
+type jsonZone struct {
+	Timezone     string `json:"timezone"`
+	City         string `json:"city"`
+	Country      string `json:"country,omitempty"`
+	Abbreviation string `json:"abbreviation"`
+	LocalTime    string `json:"local_time"`
+	ObservesDST  bool   `json:"observes_dst"`
+}
+
+type jsonOffsetGroup struct {
+	Offset        string     `json:"offset"`
+	OffsetSeconds int        `json:"offset_seconds"`
+	Zones         []jsonZone `json:"zones"`
+}
+
+// FormatZoneList formats zones grouped by UTC offset as JSON
+func (f *JSONFormatter) FormatZoneList(list *usecases.ZoneList) ([]byte, error) {
+	out := struct {
+		At     string            `json:"at"`
+		Count  int               `json:"count"`
+		Groups []jsonOffsetGroup `json:"groups"`
+	}{
+		At:     list.At.UTC().Format(time.RFC3339),
+		Count:  list.Count(),
+		Groups: make([]jsonOffsetGroup, 0, len(list.Groups)),
+	}
+
+	for _, group := range list.Groups {
+		jg := jsonOffsetGroup{
+			Offset:        domain.FormatUTCOffset(group.Offset),
+			OffsetSeconds: group.Offset,
+			Zones:         make([]jsonZone, 0, len(group.Zones)),
+		}
+		for _, z := range group.Zones {
+			country := ""
+			if code := z.Timezone.CountryCode(); code != "" {
+				country = domain.CountryName(code)
+			}
+			jg.Zones = append(jg.Zones, jsonZone{
+				Timezone:     z.Timezone.String(),
+				City:         z.City,
+				Country:      country,
+				Abbreviation: z.Abbreviation,
+				LocalTime:    z.CurrentTime.Format(time.RFC3339),
+				ObservesDST:  z.ObservesDST,
+			})
+		}
+		out.Groups = append(out.Groups, jg)
+	}
+
+	return marshalJSON(out)
+}
+
+// FormatError formats error messages as a JSON object
+func (f *JSONFormatter) FormatError(message string) ([]byte, error) {
+	return marshalJSON(struct {
+		Error string `json:"error"`
+	}{Error: message})
+}
+
+// marshalJSON indents v and terminates it with a newline
+func marshalJSON(v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,124 @@
+package presenter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
+)
+
+func TestJSONFormatter_ShouldFormatOverlapSlotsWithLocalTimes(t *testing.T) {
+	// Given a JSON formatter and an overlap between London and New York
+	formatter := NewJSONFormatter()
+	overlap := newTestOverlap(t)
+
+	// When formatting the overlap
+	output, err := formatter.FormatOverlap(overlap)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	var result struct {
+		Participants []map[string]string `json:"participants"`
+		Slots        []struct {
+			Start           string                       `json:"start"`
+			DurationMinutes int                          `json:"duration_minutes"`
+			Local           map[string]map[string]string `json:"local"`
+		} `json:"slots"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+
+	// Then slots should carry UTC bounds, duration and local times per participant
+	if len(result.Participants) != 2 || result.Participants[1]["timezone"] != "America/New_York" {
+		t.Errorf("Unexpected participants %v", result.Participants)
+	}
+	if len(result.Slots) != 1 {
+		t.Fatalf("Expected 1 slot, got %d", len(result.Slots))
+	}
+	slot := result.Slots[0]
+	if slot.Start != "2026-06-10T13:00:00Z" || slot.DurationMinutes != 180 {
+		t.Errorf("Unexpected slot %+v", slot)
+	}
+	if slot.Local["New York"]["start"] != "2026-06-10T09:00:00-04:00" {
+		t.Errorf("Unexpected local start %v", slot.Local["New York"])
+	}
+}
+
+func TestJSONFormatter_ShouldFormatErrorsAsObject(t *testing.T) {
+	output, err := NewJSONFormatter().FormatError("Something went wrong")
+	if err != nil {
+		t.Fatalf("Expected successful error formatting, got error: %v", err)
+	}
+	var result map[string]string
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if result["error"] != "Something went wrong" {
+		t.Errorf("Unexpected error object %v", result)
+	}
+}
+
+func newTestOverlap(t *testing.T) *usecases.Overlap {
+	t.Helper()
+	london, _ := domain.NewTimezone("Europe/London")
+	newYork, _ := domain.NewTimezone("America/New_York")
+	loc, _ := london.Location()
+	from := time.Date(2026, time.June, 10, 0, 0, 0, 0, loc)
+
+	participants := []*domain.Participant{
+		{Name: "London", Timezone: london, Hours: domain.DefaultWorkingHours},
+		{Name: "New York", Timezone: newYork, Hours: domain.DefaultWorkingHours},
+	}
+	start := time.Date(2026, time.June, 10, 14, 0, 0, 0, loc)
+	return &usecases.Overlap{
+		Participants: participants,
+		Slots:        []domain.TimeSlot{{Start: start, End: start.Add(3 * time.Hour)}},
+		From:         from,
+		To:           from.AddDate(0, 0, 1),
+	}
+}
+
+func TestJSONFormatter_ShouldFormatZonesGroupedByOffset(t *testing.T) {
+	// Given the zones at UTC+05:45
+	at := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+	list, err := usecases.NewZoneListUseCase(NewJSONFormatter()).WithClock(usecases.FixedClock(at)).
+		FindZoneList(usecases.ZoneListRequest{Offset: "+05:45"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// When formatting them as JSON
+	output, err := NewJSONFormatter().FormatZoneList(list)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then Kathmandu should be listed under its offset with its local time
+	var result struct {
+		Count  int `json:"count"`
+		Groups []struct {
+			Offset        string `json:"offset"`
+			OffsetSeconds int    `json:"offset_seconds"`
+			Zones         []struct {
+				Timezone    string `json:"timezone"`
+				Country     string `json:"country"`
+				LocalTime   string `json:"local_time"`
+				ObservesDST bool   `json:"observes_dst"`
+			} `json:"zones"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if result.Count != 1 || len(result.Groups) != 1 || result.Groups[0].Offset != "UTC+05:45" || result.Groups[0].OffsetSeconds != 20700 {
+		t.Fatalf("unexpected groups %+v", result)
+	}
+	zone := result.Groups[0].Zones[0]
+	if zone.Timezone != "Asia/Kathmandu" || zone.Country != "Nepal" || zone.LocalTime != "2026-01-15T17:45:00+05:45" || zone.ObservesDST {
+		t.Errorf("unexpected zone %+v", zone)
+	}
+}
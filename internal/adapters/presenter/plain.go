@@ -1,38 +1,330 @@
 package presenter
 
 import (
+	"bytes"
 	"fmt"
+	"text/tabwriter"
 	"time"
 
 	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 // PlainFormatter formats output as plain text
-type PlainFormatter struct{}
+type PlainFormatter struct {
+	home        *domain.Timezone
+	schedule    domain.Schedule
+	coordinates bool
+}
 
 // NewPlainFormatter creates a new PlainFormatter
 func NewPlainFormatter() *PlainFormatter {
-	return &PlainFormatter{}
+	return &PlainFormatter{schedule: domain.DefaultSchedule}
+}
+
+// WithHomeTimezone makes the formatter show each time relative to the user's home timezone
+func (f *PlainFormatter) WithHomeTimezone(home *domain.Timezone) *PlainFormatter {
+	f.home = home
+	return f
+}
+
+// WithSchedule sets the working and sleeping hours used to say what people are probably doing
+func (f *PlainFormatter) WithSchedule(schedule domain.Schedule) *PlainFormatter {
+	f.schedule = schedule
+	return f
+}
+
+// WithCoordinates makes FormatTimezoneInfo follow the zone with the geocoded place's
+// coordinates, e.g. "Europe/Paris 48.858400,2.294500", for piping into timein
+func (f *PlainFormatter) WithCoordinates(coordinates bool) *PlainFormatter {
+	f.coordinates = coordinates
+	return f
 }
 
 // FormatTimezoneInfo formats timezone information as plain text
 func (f *PlainFormatter) FormatTimezoneInfo(timezone *domain.Timezone, city string, cached bool) ([]byte, error) {
+	if f.coordinates {
+		return []byte(timezone.PlacedName() + "\n"), nil
+	}
 	return []byte(timezone.String() + "\n"), nil
 }
 
-// FormatTimeInfo formats current time information as plain text
-func (f *PlainFormatter) FormatTimeInfo(tz *domain.Timezone) ([]byte, error) {
+// FormatTimezoneCandidates formats the best timezone among places sharing a name, or
+// with coordinates every one of them, one per line, for timein to show side by side
+func (f *PlainFormatter) FormatTimezoneCandidates(query string, candidates []*domain.Timezone) ([]byte, error) {
+	if !f.coordinates {
+		return f.FormatTimezoneInfo(candidates[0], query, false)
+	}
+	var buf bytes.Buffer
+	for _, tz := range candidates {
+		buf.WriteString(tz.PlacedName() + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatTimeInfo formats the time at an instant as plain text
+func (f *PlainFormatter) FormatTimeInfo(tz *domain.Timezone, at time.Time) ([]byte, error) {
 	loc, err := tz.Location()
 	if err != nil {
 		return nil, err
 	}
 
-	now := time.Now().In(loc)
+	now := at.In(loc)
 	// Human-friendly, locale-aware output
 	humanTime := now.Format("Monday, 02 January 2006, 3:04:05 PM")
+	if rel := relativeToHome(f.home, now); rel != "" {
+		humanTime += " (" + rel + ")"
+	}
+	humanTime += " – " + dayPeriodLabel(f.schedule, tz, now)
 	return []byte(humanTime + "\n"), nil
 }
 
+// FormatConversion formats a time conversion as plain text
+func (f *PlainFormatter) FormatConversion(conversion *usecases.Conversion) ([]byte, error) {
+	source, target := conversion.Source, conversion.Target
+	line := fmt.Sprintf("%s %s (%s) = %s %s (%s)\n",
+		source.CurrentTime.Format("Monday, 02 January 2006, 3:04 PM"), source.Abbreviation, source.City,
+		target.CurrentTime.Format("Monday, 02 January 2006, 3:04 PM"), target.Abbreviation, target.City)
+	return []byte(line), nil
+}
+
+// FormatWorldClock formats timezones as an aligned table of city, local time, abbreviation,
+// offset and what people there are probably doing
+func (f *PlainFormatter) FormatWorldClock(infos []*usecases.TimezoneInfo) ([]byte, error) {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, info := range infos {
+		_, offset := info.CurrentTime.Zone()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s",
+			info.City,
+			info.CurrentTime.Format("Mon 02 Jan, 3:04 PM"),
+			info.Abbreviation,
+			domain.FormatUTCOffset(offset),
+			dayPeriodLabel(f.schedule, info.Timezone, info.CurrentTime))
+		if rel := relativeToHome(f.home, info.CurrentTime); rel != "" {
+			fmt.Fprintf(w, "\t%s", rel)
+		}
+		fmt.Fprintln(w)
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatOverlap formats shared meeting slots as one line per slot
+func (f *PlainFormatter) FormatOverlap(overlap *usecases.Overlap) ([]byte, error) {
+	if len(overlap.Slots) == 0 {
+		return []byte(fmt.Sprintf("No overlapping working hours for %s\n", participantSummary(overlap.Participants))), nil
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, slot := range overlap.Slots {
+		start, _ := localSlot(slot, overlap.Participants[0])
+		fmt.Fprintf(w, "%s\t%s", start.Format("Mon 02 Jan"), formatDuration(slot.Duration()))
+		for _, p := range overlap.Participants {
+			s, e := localSlot(slot, p)
+			fmt.Fprintf(w, "\t%s %s-%s", p.Name, s.Format("15:04"), e.Format("15:04"))
+		}
+		fmt.Fprintln(w)
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatTransitions formats UTC-offset transitions as one line each
+func (f *PlainFormatter) FormatTransitions(report *usecases.TransitionReport) ([]byte, error) {
+	if len(report.Transitions) == 0 {
+		return []byte(fmt.Sprintf("No UTC offset changes in %s\n", report.Timezone.String())), nil
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for i := range report.Transitions {
+		tr := &report.Transitions[i]
+		upcoming, _ := describeTransition(tr)
+		before, after := transitionWallClocks(tr)
+		fmt.Fprintf(w, "%s\t%s → %s\t%s → %s\t%s\n",
+			before.Format("Mon 02 Jan 2006"),
+			before.Format("15:04 MST"), after.Format("15:04 MST"),
+			domain.FormatUTCOffset(tr.OldOffset), domain.FormatUTCOffset(tr.NewOffset),
+			upcoming)
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatHistory formats a zone's changes as one line each, or the period in effect at an instant
+func (f *PlainFormatter) FormatHistory(history *usecases.ZoneHistory) ([]byte, error) {
+	if period := history.Period; period != nil {
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "%s on %s: %s (%s), %s\n",
+			history.Timezone.String(), history.At.Format("Mon 02 Jan 2006 15:04"),
+			domain.FormatUTCOffset(period.Offset), period.Abbreviation, describePeriod(period))
+
+		start, end := periodWallClocks(period)
+		from, until := "the earliest record", "no change scheduled"
+		if !start.IsZero() {
+			from = start.Format("Mon 02 Jan 2006 15:04")
+		}
+		if !end.IsZero() {
+			until = end.Format("Mon 02 Jan 2006 15:04")
+		}
+		fmt.Fprintf(&buf, "In effect from %s until %s\n", from, until)
+		return buf.Bytes(), nil
+	}
+
+	if len(history.Changes) == 0 {
+		return []byte(fmt.Sprintf("No offset or abbreviation changes in %s\n", history.Timezone.String())), nil
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for i := range history.Changes {
+		tr := &history.Changes[i]
+		kind, past := describeTransition(tr)
+		if tr.At.Before(history.Now) {
+			kind = past
+		}
+		before, after := transitionWallClocks(tr)
+		fmt.Fprintf(w, "%s\t%s → %s\t%s → %s\t%s\n",
+			before.Format("Mon 02 Jan 2006"),
+			before.Format("15:04 MST"), after.Format("15:04 MST"),
+			domain.FormatUTCOffset(tr.OldOffset), domain.FormatUTCOffset(tr.NewOffset),
+			kind)
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatVersion formats the binary, tzdata and dataset releases as an aligned list
+func (f *PlainFormatter) FormatVersion(report *usecases.VersionReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "timein\t%s\n", versionOrUnknown(report.Build))
+	fmt.Fprintf(w, "tzdata mode\t%s\n", report.Mode)
+	fmt.Fprintf(w, "host tzdata\t%s\n", versionOrUnknown(report.Host))
+	fmt.Fprintf(w, "embedded tzdata\t%s\n", versionOrUnknown(report.Embedded))
+	fmt.Fprintf(w, "tzf dataset\t%s\n", versionOrUnknown(report.Tzf))
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatTzdataDiff formats one line per compared zone followed by a summary
+func (f *PlainFormatter) FormatTzdataDiff(diff *usecases.TzdataDiff) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Comparing %s tzdata %s with %s tzdata %s, %d to %d\n",
+		diff.Left, versionOrUnknown(diff.LeftVersion), diff.Right, versionOrUnknown(diff.RightVersion),
+		diff.From.Year(), diff.To.Year())
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, z := range diff.Zones {
+		fmt.Fprintf(w, "%s\t%s\n", z.Zone, describeRulesComparison(diff, &z))
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(&buf, "%d of %d zones differ\n", diff.Differing(), len(diff.Zones))
+	return buf.Bytes(), nil
+}
+
+// FormatZoneSearch formats the suggested zones as an aligned table of zone, place,
+// local time, abbreviation and offset
+func (f *PlainFormatter) FormatZoneSearch(search *usecases.ZoneSearch) ([]byte, error) {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, s := range search.Zones {
+		_, offset := s.CurrentTime.Zone()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			s.Timezone.String(),
+			suggestionPlace(s),
+			s.CurrentTime.Format("Mon 02 Jan, 3:04 PM"),
+			s.Abbreviation,
+			domain.FormatUTCOffset(offset))
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatZoneList formats a heading per UTC offset followed by an indented table of its
+// zones, marking those that observe DST
+func (f *PlainFormatter) FormatZoneList(list *usecases.ZoneList) ([]byte, error) {
+	if list.Count() == 0 {
+		return []byte("No zones match\n"), nil
+	}
+
+	var buf bytes.Buffer
+	for i, group := range list.Groups {
+		if i > 0 {
+			fmt.Fprintln(&buf)
+		}
+		fmt.Fprintf(&buf, "%s (%s)\n", domain.FormatUTCOffset(group.Offset), plural(len(group.Zones), "zone"))
+		w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+		for _, z := range group.Zones {
+			fmt.Fprintf(w, "  %s\t%s\t%s", z.Timezone.String(), zonePlace(z.Timezone), z.Abbreviation)
+			if z.ObservesDST {
+				fmt.Fprint(w, "\tobserves DST")
+			}
+			fmt.Fprintln(w)
+		}
+		if err := w.Flush(); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatCountdown formats the time left until the target, noting offset changes on the way
+func (f *PlainFormatter) FormatCountdown(countdown *usecases.Countdown) ([]byte, error) {
+	target := countdown.Target
+	_, offset := target.CurrentTime.Zone()
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s until %s %s in %s (%s)\n",
+		countdownDuration(countdown.Remaining),
+		target.CurrentTime.Format("Mon 02 Jan, 3:04 PM"), target.Abbreviation, target.City,
+		domain.FormatUTCOffset(offset))
+	if shifts := countdownShifts(countdown); shifts != "" {
+		fmt.Fprintf(&buf, "Clocks change on the way: %s\n", shifts)
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatParsedTimestamp formats a parsed timestamp as its detected format, a table of
+// zones and its machine-readable representations
+func (f *PlainFormatter) FormatParsedTimestamp(parsed *usecases.ParsedTimestamp) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Detected %s\n\n", parsed.Timestamp.Format)
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, info := range parsed.Zones {
+		_, offset := info.CurrentTime.Zone()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			info.City,
+			info.CurrentTime.Format("Mon 02 Jan 2006, 3:04:05 PM"),
+			info.Abbreviation,
+			domain.FormatUTCOffset(offset))
+	}
+	fmt.Fprintln(w)
+	for _, r := range timestampRepresentations(parsed.Timestamp.Time) {
+		fmt.Fprintf(w, "%s\t%s\n", r.Name, r.Value)
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // FormatError formats error messages as plain text
 func (f *PlainFormatter) FormatError(message string) ([]byte, error) {
 	return []byte(fmt.Sprintf("Error: %s\n", message)), nil
@@ -3,8 +3,10 @@ package presenter
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/loginx/alfred-timein/internal/domain"
+	"github.com/loginx/alfred-timein/internal/usecases"
 )
 
 func TestPlainFormatter_ShouldFormatTimezoneInfoWithNewline(t *testing.T) {
@@ -32,7 +34,7 @@ func TestPlainFormatter_ShouldFormatTimeInfoAsHumanReadable(t *testing.T) {
 	timezone, _ := domain.NewTimezone("Europe/London")
 	
 	// When formatting time info
-	output, err := formatter.FormatTimeInfo(timezone)
+	output, err := formatter.FormatTimeInfo(timezone, time.Now())
 	
 	// Then it should return human-readable time with newline
 	if err != nil {
@@ -47,7 +49,7 @@ func TestPlainFormatter_ShouldFormatTimeInfoAsHumanReadable(t *testing.T) {
 	}
 	
 	// Should contain readable date format
-	if !strings.Contains(result, "2025") {
+	if !strings.Contains(result, time.Now().Format("2006")) {
 		t.Error("Expected output to contain current year")
 	}
 	
@@ -102,4 +104,325 @@ func TestPlainFormatter_ShouldIgnoreCacheFlag(t *testing.T) {
 	if string(outputCached) != expected {
 		t.Errorf("Expected '%s', got '%s'", expected, string(outputCached))
 	}
-}
\ No newline at end of file
+}
+
+func TestPlainFormatter_ShouldFormatConversionOnOneLine(t *testing.T) {
+	// Given a plain formatter and a conversion from London to Tokyo
+	formatter := NewPlainFormatter()
+	conversion := newTestConversion(t)
+
+	// When formatting the conversion
+	output, err := formatter.FormatConversion(conversion)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then both sides should be shown
+	expected := "Wednesday, 10 June 2026, 3:00 PM BST (London) = Wednesday, 10 June 2026, 11:00 PM JST (Tokyo)\n"
+	if string(output) != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, string(output))
+	}
+}
+
+func newTestConversion(t *testing.T) *usecases.Conversion {
+	t.Helper()
+	london, _ := domain.NewTimezone("Europe/London")
+	tokyo, _ := domain.NewTimezone("Asia/Tokyo")
+	londonLoc, _ := london.Location()
+	tokyoLoc, _ := tokyo.Location()
+	instant := time.Date(2026, time.June, 10, 15, 0, 0, 0, londonLoc)
+
+	return &usecases.Conversion{
+		Source: &usecases.TimezoneInfo{Timezone: london, CurrentTime: instant, City: "London", Abbreviation: "BST"},
+		Target: &usecases.TimezoneInfo{Timezone: tokyo, CurrentTime: instant.In(tokyoLoc), City: "Tokyo", Abbreviation: "JST"},
+	}
+}
+
+func TestPlainFormatter_ShouldFormatWorldClockAsAlignedTable(t *testing.T) {
+	// Given a plain formatter and two zones at the same instant
+	formatter := NewPlainFormatter()
+	conversion := newTestConversion(t)
+	infos := []*usecases.TimezoneInfo{conversion.Source, conversion.Target}
+
+	// When formatting the world clock
+	output, err := formatter.FormatWorldClock(infos)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then each zone should be a row with aligned columns
+	expected := "London  Wed 10 Jun, 3:00 PM   BST  UTC+01:00  working hours\n" +
+		"Tokyo   Wed 10 Jun, 11:00 PM  JST  UTC+09:00  probably asleep\n"
+	if string(output) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldFormatOverlapAsOneLinePerSlot(t *testing.T) {
+	// Given a plain formatter and an overlap between London and New York
+	formatter := NewPlainFormatter()
+
+	// When formatting the overlap
+	output, err := formatter.FormatOverlap(newTestOverlap(t))
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then the slot should list each participant's local window
+	expected := "Wed 10 Jun  3h  London 14:00-17:00  New York 09:00-12:00\n"
+	if string(output) != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldShowOffsetRelativeToHome(t *testing.T) {
+	// Given a plain formatter with a home timezone in New York
+	home, _ := domain.NewTimezone("America/New_York")
+	formatter := NewPlainFormatter().WithHomeTimezone(home)
+	conversion := newTestConversion(t)
+
+	// When formatting a world clock
+	output, err := formatter.FormatWorldClock([]*usecases.TimezoneInfo{conversion.Target})
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then Tokyo should be shown as 13 hours ahead on the same day
+	if !strings.HasSuffix(string(output), "+13h, same day\n") {
+		t.Errorf("Expected relative offset, got '%s'", string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldFormatTransitionsWithWallClocks(t *testing.T) {
+	// Given a plain formatter and Berlin's 2026 transitions
+	formatter := NewPlainFormatter()
+	report := newTestTransitionReport(t)
+
+	// When formatting the report
+	output, err := formatter.FormatTransitions(report)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then each transition should show the wall clock jump and offsets
+	expected := "Sun 29 Mar 2026  02:00 CET → 03:00 CEST  UTC+01:00 → UTC+02:00  DST starts\n" +
+		"Sun 25 Oct 2026  03:00 CEST → 02:00 CET  UTC+02:00 → UTC+01:00  DST ends\n"
+	if string(output) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, string(output))
+	}
+}
+
+func newTestTransitionReport(t *testing.T) *usecases.TransitionReport {
+	t.Helper()
+	tz, _ := domain.NewTimezone("Europe/Berlin")
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	transitions, err := tz.Transitions(from, from.AddDate(1, 0, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &usecases.TransitionReport{
+		Timezone:    tz,
+		Transitions: transitions,
+		Year:        2026,
+		Now:         time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestPlainFormatter_ShouldFormatHistoryWithPastChanges(t *testing.T) {
+	// Given a plain formatter and Moscow's changes from 2011 to 2014
+	formatter := NewPlainFormatter()
+	tz, _ := domain.NewTimezone("Europe/Moscow")
+	changes, err := tz.History(time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	history := &usecases.ZoneHistory{
+		Timezone: tz,
+		Changes:  changes,
+		Now:      time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+	}
+
+	// When formatting the history
+	output, err := formatter.FormatHistory(history)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then each change should show the wall clock jump in the past tense
+	expected := "Sun 27 Mar 2011  02:00 MSK → 03:00 MSK  UTC+03:00 → UTC+04:00  UTC offset changed\n" +
+		"Sun 26 Oct 2014  02:00 MSK → 01:00 MSK  UTC+04:00 → UTC+03:00  UTC offset changed\n"
+	if string(output) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldFormatHistoryPeriodAtDate(t *testing.T) {
+	// Given a plain formatter and the period in effect in Caracas in 2010
+	formatter := NewPlainFormatter()
+	tz, _ := domain.NewTimezone("America/Caracas")
+	loc, _ := tz.Location()
+	at := time.Date(2010, time.January, 1, 0, 0, 0, 0, loc)
+	period, err := tz.PeriodAt(at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// When formatting it
+	output, err := formatter.FormatHistory(&usecases.ZoneHistory{Timezone: tz, At: at, Period: period})
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then the offset and its bounds should be shown on the wall clock of that period
+	expected := "America/Caracas on Fri 01 Jan 2010 00:00: UTC-04:30 (-0430), standard time\n" +
+		"In effect from Sun 09 Dec 2007 02:30 until Sun 01 May 2016 02:30\n"
+	if string(output) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldFormatTzdataDiffWithSummary(t *testing.T) {
+	// Given a plain formatter and a comparison where London's copies disagree
+	formatter := NewPlainFormatter()
+	london, _ := time.LoadLocation("Europe/London")
+	from := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2036, time.January, 1, 0, 0, 0, 0, time.UTC)
+	diff := &usecases.TzdataDiff{
+		Left: "host", LeftVersion: "2025b", Right: "embedded", RightVersion: "2026c",
+		From: from, To: to,
+		Zones: []usecases.ZoneRulesComparison{
+			{Zone: "Asia/Tokyo"},
+			{Zone: "Europe/London", Difference: domain.DiffZoneRules(london, time.FixedZone("GMT", 0), time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), to)},
+			{Zone: "Europe/Kyiv", Missing: "host"},
+		},
+	}
+
+	// When formatting it
+	output, err := formatter.FormatTzdataDiff(diff)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then each zone should get a line and the differing ones should be counted
+	expected := "Comparing host tzdata 2025b with embedded tzdata 2026c, 1970 to 2036\n" +
+		"Asia/Tokyo     same\n" +
+		"Europe/London  differs from 2026-03-29 01:00 UTC: host UTC+01:00 (BST), embedded UTC+00:00 (GMT)\n" +
+		"Europe/Kyiv    missing from host\n" +
+		"2 of 3 zones differ\n"
+	if string(output) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldFormatParsedTimestampInEveryZone(t *testing.T) {
+	// Given a plain formatter and a timestamp shown in London and Tokyo
+	formatter := NewPlainFormatter()
+	parsed := newTestParsedTimestamp(t)
+
+	// When formatting the parsed timestamp
+	output, err := formatter.FormatParsedTimestamp(parsed)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then it should name the detected format, each zone and each representation
+	expected := "Detected Unix seconds\n\n" +
+		"London  Wed 10 Jun 2026, 3:00:00 PM   BST  UTC+01:00\n" +
+		"Tokyo   Wed 10 Jun 2026, 11:00:00 PM  JST  UTC+09:00\n" +
+		"\n" +
+		"Unix seconds       1781100000\n" +
+		"Unix milliseconds  1781100000000\n" +
+		"RFC 3339           2026-06-10T14:00:00Z\n" +
+		"RFC 1123           Wed, 10 Jun 2026 14:00:00 GMT\n"
+	if string(output) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, string(output))
+	}
+}
+
+func newTestParsedTimestamp(t *testing.T) *usecases.ParsedTimestamp {
+	t.Helper()
+	conversion := newTestConversion(t)
+	return &usecases.ParsedTimestamp{
+		Input:     "1781100000",
+		Timestamp: &domain.Timestamp{Time: conversion.Source.CurrentTime.UTC(), Format: "Unix seconds"},
+		Zones:     []*usecases.TimezoneInfo{conversion.Source, conversion.Target},
+	}
+}
+
+func TestPlainFormatter_ShouldFormatTimeInfoAtGivenInstant(t *testing.T) {
+	// Given a plain formatter and a fixed instant
+	formatter := NewPlainFormatter()
+	tz, _ := domain.NewTimezone("Australia/Sydney")
+	at := time.Date(2026, time.March, 29, 1, 30, 0, 0, time.UTC)
+
+	// When formatting time info at that instant
+	output, err := formatter.FormatTimeInfo(tz, at)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then it should show Sydney's wall clock at that instant
+	expected := "Sunday, 29 March 2026, 12:30:00 PM – weekend\n"
+	if string(output) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldNamePublicHolidays(t *testing.T) {
+	// Given a plain formatter and Tokyo at noon on Showa Day
+	formatter := NewPlainFormatter()
+	tz, _ := domain.NewTimezone("Asia/Tokyo")
+	at := time.Date(2026, time.April, 29, 3, 0, 0, 0, time.UTC)
+
+	// When formatting time info at that instant
+	output, err := formatter.FormatTimeInfo(tz, at)
+	if err != nil {
+		t.Fatalf("Expected successful formatting, got error: %v", err)
+	}
+
+	// Then it should name the holiday instead of working hours
+	expected := "Wednesday, 29 April 2026, 12:00:00 PM – public holiday (Showa Day)\n"
+	if string(output) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(output))
+	}
+}
+
+func TestPlainFormatter_ShouldFollowTimezoneWithCoordinatesWhenAsked(t *testing.T) {
+	// Given a geocoded timezone
+	timezone, _ := domain.NewTimezone("Europe/Paris")
+	timezone.Place, _ = domain.NewLocation("Eiffel Tower", 48.8584, 2.2945)
+
+	// When formatting with and without coordinates
+	plain, _ := NewPlainFormatter().FormatTimezoneInfo(timezone, "Eiffel Tower", false)
+	placed, _ := NewPlainFormatter().WithCoordinates(true).FormatTimezoneInfo(timezone, "Eiffel Tower", false)
+
+	// Then only the latter should carry them, in the form timein reads back
+	if string(plain) != "Europe/Paris\n" {
+		t.Errorf("Expected 'Europe/Paris', got %q", string(plain))
+	}
+	if string(placed) != "Europe/Paris 48.858400,2.294500\n" {
+		t.Errorf("Expected coordinates, got %q", string(placed))
+	}
+}
+
+func TestPlainFormatter_ShouldListCandidatesOnlyWithCoordinates(t *testing.T) {
+	// Given places named Portland in two timezones
+	oregon, _ := domain.NewTimezone("America/Los_Angeles")
+	oregon.Place, _ = domain.NewLocation("Portland", 45.52345, -122.67621)
+	maine, _ := domain.NewTimezone("America/New_York")
+	maine.Place, _ = domain.NewLocation("Portland", 43.66147, -70.25533)
+	candidates := []*domain.Timezone{oregon, maine}
+
+	// When formatting them with and without coordinates
+	plain, _ := NewPlainFormatter().FormatTimezoneCandidates("Portland", candidates)
+	placed, _ := NewPlainFormatter().WithCoordinates(true).FormatTimezoneCandidates("Portland", candidates)
+
+	// Then scripts should get the best zone, and timein every zone one per line
+	if string(plain) != "America/Los_Angeles\n" {
+		t.Errorf("Expected the best zone, got %q", string(plain))
+	}
+	expected := "America/Los_Angeles 45.523450,-122.676210\nAmerica/New_York 43.661470,-70.255330\n"
+	if string(placed) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(placed))
+	}
+}
@@ -2,6 +2,7 @@ package timezonefinder
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/ringsaturn/tzf"
 )
@@ -30,4 +31,46 @@ func (tf *TzfTimezoneFinder) GetTimezoneName(longitude, latitude float64) (strin
 		return "", fmt.Errorf("no timezone found for coordinates: %f, %f", latitude, longitude)
 	}
 	return tz, nil
-}
\ No newline at end of file
+}
+
+// DataVersion returns the release of the tzf boundary dataset
+func (tf *TzfTimezoneFinder) DataVersion() string {
+	return tf.finder.DataVersion()
+}
+
+// LazyTzfTimezoneFinder defers loading the tzf dataset until the first lookup,
+// so commands that usually hit the cache or take zone names stay fast
+type LazyTzfTimezoneFinder struct {
+	once   sync.Once
+	finder *TzfTimezoneFinder
+	err    error
+}
+
+// NewLazyTzfTimezoneFinder creates a new LazyTzfTimezoneFinder
+func NewLazyTzfTimezoneFinder() *LazyTzfTimezoneFinder {
+	return &LazyTzfTimezoneFinder{}
+}
+
+// GetTimezoneName returns the timezone name for given coordinates
+func (tf *LazyTzfTimezoneFinder) GetTimezoneName(longitude, latitude float64) (string, error) {
+	if err := tf.load(); err != nil {
+		return "", err
+	}
+	return tf.finder.GetTimezoneName(longitude, latitude)
+}
+
+// DataVersion returns the release of the tzf boundary dataset, loading it if needed
+func (tf *LazyTzfTimezoneFinder) DataVersion() (string, error) {
+	if err := tf.load(); err != nil {
+		return "", err
+	}
+	return tf.finder.DataVersion(), nil
+}
+
+// load reads the tzf dataset on first use
+func (tf *LazyTzfTimezoneFinder) load() error {
+	tf.once.Do(func() {
+		tf.finder, tf.err = NewTzfTimezoneFinder()
+	})
+	return tf.err
+}
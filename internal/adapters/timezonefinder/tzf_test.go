@@ -48,4 +48,14 @@ func TestTzfTimezoneFinder_GetTimezoneName(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestLazyTzfTimezoneFinder_DataVersion(t *testing.T) {
+	version, err := NewLazyTzfTimezoneFinder().DataVersion()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version == "" {
+		t.Error("expected a dataset version")
+	}
+}
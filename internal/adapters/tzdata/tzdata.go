@@ -0,0 +1,201 @@
+package tzdata
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// zoneinfo.zip and VERSION are generated by `make tzdata`, together with the tables in
+// internal/domain/tzdata, from one tzdb release. VERSION also pins that release: `make
+// tzdata` rebuilds it unless given another, whatever release the Go toolchain bundles
+var (
+	//go:embed zoneinfo.zip
+	zoneinfoZip []byte
+
+	//go:embed VERSION
+	embeddedVersion string
+)
+
+// EnvVar names the workflow variable that picks the tzdata mode
+const EnvVar = "TIMEIN_TZDATA"
+
+// Mode selects which copy of the IANA tz database zone rules come from
+type Mode string
+
+const (
+	// ModeAuto uses the host's zoneinfo, falling back to the embedded copy for zones it lacks
+	ModeAuto Mode = "auto"
+	// ModeHost uses only the host's zoneinfo
+	ModeHost Mode = "host"
+	// ModeEmbedded uses only the copy built into the binary
+	ModeEmbedded Mode = "embedded"
+)
+
+// ParseMode reads a mode name; empty means ModeAuto
+func ParseMode(name string) (Mode, error) {
+	switch Mode(strings.ToLower(strings.TrimSpace(name))) {
+	case "", ModeAuto:
+		return ModeAuto, nil
+	case ModeHost:
+		return ModeHost, nil
+	case ModeEmbedded:
+		return ModeEmbedded, nil
+	default:
+		return "", fmt.Errorf("invalid tzdata mode: %s (want auto, host or embedded)", name)
+	}
+}
+
+// Loader returns the zone loader for a mode, for domain.SetLocationLoader
+func Loader(mode Mode) func(name string) (*time.Location, error) {
+	host, embedded := NewHostSource(), NewEmbeddedSource()
+	switch mode {
+	case ModeHost:
+		return host.LoadLocation
+	case ModeEmbedded:
+		return embedded.LoadLocation
+	default:
+		return func(name string) (*time.Location, error) {
+			loc, err := host.LoadLocation(name)
+			if err != nil {
+				if fallback, embeddedErr := embedded.LoadLocation(name); embeddedErr == nil {
+					return fallback, nil
+				}
+			}
+			return loc, err
+		}
+	}
+}
+
+// hostZoneinfoDirs are where Unix systems install zoneinfo, in the order Go searches them
+var hostZoneinfoDirs = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+}
+
+// HostSource reads zone rules from the host's zoneinfo through time.LoadLocation
+type HostSource struct {
+	dirs []string
+}
+
+// NewHostSource creates a new HostSource
+func NewHostSource() *HostSource {
+	dirs := hostZoneinfoDirs
+	if dir := os.Getenv("ZONEINFO"); dir != "" {
+		dirs = append([]string{dir}, dirs...)
+	}
+	return &HostSource{dirs: dirs}
+}
+
+// Name returns "host"
+func (s *HostSource) Name() string {
+	return string(ModeHost)
+}
+
+// LoadLocation loads a zone from the host's zoneinfo
+func (s *HostSource) LoadLocation(name string) (*time.Location, error) {
+	return time.LoadLocation(name)
+}
+
+// Version returns the tzdata release of the host's zoneinfo, read from tzdata.zi or the
+// +VERSION file macOS ships, or "" when neither is found
+func (s *HostSource) Version() string {
+	for _, dir := range s.dirs {
+		if version := readZiVersion(filepath.Join(dir, "tzdata.zi")); version != "" {
+			return version
+		}
+		if data, err := os.ReadFile(filepath.Join(dir, "+VERSION")); err == nil {
+			return strings.TrimSpace(string(data))
+		}
+	}
+	return ""
+}
+
+// readZiVersion reads the "# version 2025b" header of a tzdata.zi file
+func readZiVersion(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	line, _ := bufio.NewReader(f).ReadString('\n')
+	if version, ok := strings.CutPrefix(strings.TrimSpace(line), "# version "); ok {
+		return strings.TrimSpace(version)
+	}
+	return ""
+}
+
+// EmbeddedSource reads zone rules from the tzdata built into the binary
+type EmbeddedSource struct {
+	once  sync.Once
+	files map[string]*zip.File
+	err   error
+}
+
+// NewEmbeddedSource creates a new EmbeddedSource
+func NewEmbeddedSource() *EmbeddedSource {
+	return &EmbeddedSource{}
+}
+
+// Name returns "embedded"
+func (s *EmbeddedSource) Name() string {
+	return string(ModeEmbedded)
+}
+
+// Version returns the tzdata release built into the binary
+func (s *EmbeddedSource) Version() string {
+	return strings.TrimSpace(embeddedVersion)
+}
+
+// LoadLocation loads a zone from the embedded tzdata
+func (s *EmbeddedSource) LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "UTC" {
+		return time.UTC, nil
+	}
+	if name == "Local" {
+		return time.Local, nil
+	}
+
+	s.once.Do(s.index)
+	if s.err != nil {
+		return nil, s.err
+	}
+	file, ok := s.files[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown time zone %s in embedded tzdata", name)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	return time.LoadLocationFromTZData(name, data)
+}
+
+// index reads the embedded archive's directory once
+func (s *EmbeddedSource) index() {
+	r, err := zip.NewReader(bytes.NewReader(zoneinfoZip), int64(len(zoneinfoZip)))
+	if err != nil {
+		s.err = fmt.Errorf("failed to read embedded tzdata: %w", err)
+		return
+	}
+	s.files = make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		s.files[f.Name] = f
+	}
+}
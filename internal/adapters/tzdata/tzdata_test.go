@@ -0,0 +1,103 @@
+package tzdata
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseMode(t *testing.T) {
+	tests := map[string]Mode{"": ModeAuto, "auto": ModeAuto, "Host": ModeHost, " embedded ": ModeEmbedded}
+	for input, expected := range tests {
+		if got, err := ParseMode(input); err != nil || got != expected {
+			t.Errorf("for %q, expected %s, got %s, %v", input, expected, got, err)
+		}
+	}
+	if _, err := ParseMode("bundled"); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
+
+func TestEmbeddedSource_LoadLocation(t *testing.T) {
+	source := NewEmbeddedSource()
+
+	loc, err := source.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name, offset := time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC).In(loc).Zone(); name != "BST" || offset != 3600 {
+		t.Errorf("expected BST in July, got %s %d", name, offset)
+	}
+	if loc.String() != "Europe/London" {
+		t.Errorf("expected the zone to keep its name, got %s", loc)
+	}
+
+	if _, err := source.LoadLocation("Mars/Olympus_Mons"); err == nil {
+		t.Error("expected error for unknown zone")
+	}
+	if source.Version() == "" {
+		t.Error("expected an embedded tzdata version")
+	}
+}
+
+func TestLoader_ShouldUseEmbeddedTzdataWhenAsked(t *testing.T) {
+	load := Loader(ModeEmbedded)
+	if loc, err := load("Asia/Tokyo"); err != nil || loc.String() != "Asia/Tokyo" {
+		t.Errorf("expected Asia/Tokyo, got %v, %v", loc, err)
+	}
+	if _, err := Loader(ModeAuto)("Not/AZone"); err == nil {
+		t.Error("expected error for unknown zone")
+	}
+}
+
+func TestHostSource_Version_ShouldReadTzdataZi(t *testing.T) {
+	dir := t.TempDir()
+	zi := "# version 2025b\n# This zic input file is in the public domain.\n"
+	if err := os.WriteFile(filepath.Join(dir, "tzdata.zi"), []byte(zi), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	source := &HostSource{dirs: []string{dir}}
+	if got := source.Version(); got != "2025b" {
+		t.Errorf("expected 2025b, got %q", got)
+	}
+	if got := (&HostSource{dirs: []string{t.TempDir()}}).Version(); got != "" {
+		t.Errorf("expected no version, got %q", got)
+	}
+}
+
+func TestEmbeddedSource_ShouldMatchTheDomainTables(t *testing.T) {
+	// Given the backward links generated alongside the embedded zone rules
+	data, err := os.ReadFile(filepath.Join("..", "..", "domain", "tzdata", "backward"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := NewEmbeddedSource()
+
+	// Then they should come from the same tzdb release
+	if !strings.Contains(string(data), "("+source.Version()+")") {
+		t.Errorf("backward does not come from embedded tzdata %s; run make tzdata", source.Version())
+	}
+	zones, err := os.ReadFile(filepath.Join("..", "..", "domain", "tzdata", "zones"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(zones), "("+source.Version()+")") {
+		t.Errorf("zones does not come from embedded tzdata %s; run make tzdata", source.Version())
+	}
+
+	// And every link and its target should load from the embedded zone rules
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != "Link" {
+			continue
+		}
+		for _, name := range fields[1:] {
+			if _, err := source.LoadLocation(name); err != nil {
+				t.Errorf("embedded tzdata lacks %s: %v", name, err)
+			}
+		}
+	}
+}
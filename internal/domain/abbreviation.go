@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// abbreviationZones maps common timezone abbreviations that are not themselves
+// tzdb zone names to the zones they denote; ambiguous ones list every candidate,
+// most commonly meant first
+var abbreviationZones = map[string][]string{
+	// North America
+	"PT":   {"America/Los_Angeles"},
+	"PST":  {"America/Los_Angeles"},
+	"PDT":  {"America/Los_Angeles"},
+	"MT":   {"America/Denver"},
+	"MDT":  {"America/Denver"},
+	"CT":   {"America/Chicago"},
+	"CST":  {"America/Chicago", "Asia/Shanghai", "America/Havana"},
+	"CDT":  {"America/Chicago", "America/Havana"},
+	"ET":   {"America/New_York"},
+	"EDT":  {"America/New_York"},
+	"AKST": {"America/Anchorage"},
+	"AKDT": {"America/Anchorage"},
+	"AST":  {"America/Halifax", "Asia/Riyadh"},
+	"ADT":  {"America/Halifax"},
+	"NST":  {"America/St_Johns"},
+	"NDT":  {"America/St_Johns"},
+
+	// South America
+	"BRT": {"America/Sao_Paulo"},
+	"ART": {"America/Argentina/Buenos_Aires"},
+
+	// Europe and Africa
+	"BST":  {"Europe/London", "Asia/Dhaka"},
+	"IST":  {"Asia/Kolkata", "Europe/Dublin", "Asia/Jerusalem"},
+	"WEST": {"Europe/Lisbon"},
+	"CEST": {"Europe/Paris"},
+	"EEST": {"Europe/Athens"},
+	"MSK":  {"Europe/Moscow"},
+	"WAT":  {"Africa/Lagos"},
+	"CAT":  {"Africa/Maputo"},
+	"EAT":  {"Africa/Nairobi"},
+	"SAST": {"Africa/Johannesburg"},
+
+	// Asia
+	"IDT": {"Asia/Jerusalem"},
+	"GST": {"Asia/Dubai"},
+	"PKT": {"Asia/Karachi"},
+	"NPT": {"Asia/Kathmandu"},
+	"ICT": {"Asia/Bangkok"},
+	"WIB": {"Asia/Jakarta"},
+	"SGT": {"Asia/Singapore"},
+	"HKT": {"Asia/Hong_Kong"},
+	"PHT": {"Asia/Manila"},
+	"JST": {"Asia/Tokyo"},
+	"KST": {"Asia/Seoul"},
+
+	// Oceania
+	"AWST": {"Australia/Perth"},
+	"ACST": {"Australia/Adelaide"},
+	"ACDT": {"Australia/Adelaide"},
+	"AEST": {"Australia/Sydney"},
+	"AEDT": {"Australia/Sydney"},
+	"NZST": {"Pacific/Auckland"},
+	"NZDT": {"Pacific/Auckland"},
+}
+
+// AmbiguousAbbreviationError is returned when an abbreviation denotes several zones
+type AmbiguousAbbreviationError struct {
+	Abbreviation string
+	Candidates   []*Timezone
+}
+
+func (e *AmbiguousAbbreviationError) Error() string {
+	names := make([]string, len(e.Candidates))
+	for i, tz := range e.Candidates {
+		names[i] = tz.Name
+	}
+	return fmt.Sprintf("ambiguous timezone abbreviation %s: %s", e.Abbreviation, strings.Join(names, ", "))
+}
+
+// TimezonesForAbbreviation returns the zones an abbreviation such as "PST" or "IST"
+// may denote, or nil if it is not a known abbreviation. Abbreviations must be written in
+// upper case, so words such as "cat" or "ist" are left to be geocoded
+func TimezonesForAbbreviation(abbr string) []*Timezone {
+	abbr = strings.TrimSpace(abbr)
+	if abbr != strings.ToUpper(abbr) {
+		return nil
+	}
+	names := abbreviationZones[abbr]
+	zones := make([]*Timezone, 0, len(names))
+	for _, name := range names {
+		zones = append(zones, &Timezone{Name: name})
+	}
+	if len(zones) == 0 {
+		return nil
+	}
+	return zones
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewTimezone_ShouldAcceptUnambiguousAbbreviations(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"PST", "America/Los_Angeles"},
+		{"AEST", "Australia/Sydney"},
+		{"JST", "Asia/Tokyo"},
+		{"est", "EST"},
+		{"utc", "UTC"},
+	}
+
+	for _, test := range tests {
+		tz, err := NewTimezone(test.input)
+		if err != nil {
+			t.Errorf("NewTimezone(%q) failed: %v", test.input, err)
+			continue
+		}
+		if tz.Name != test.expected {
+			t.Errorf("NewTimezone(%q) = %s, want %s", test.input, tz.Name, test.expected)
+		}
+	}
+}
+
+func TestNewTimezone_ShouldNotReadLowercaseWordsAsAbbreviations(t *testing.T) {
+	// Given words that are abbreviations only when written in upper case
+	for _, input := range []string{"cat", "ist", "aest", "Cat"} {
+		// When reading them as timezones
+		tz, err := NewTimezone(input)
+
+		// Then they should not be taken for one
+		if err == nil {
+			t.Errorf("NewTimezone(%q) = %s, want an error", input, tz.Name)
+		}
+	}
+}
+
+func TestNewTimezone_ShouldPreferTzdbNamesOverAbbreviations(t *testing.T) {
+	// Given an abbreviation that is also a tzdb zone
+	tz, err := NewTimezone("CET")
+
+	// Then the tzdb zone should be used as-is
+	if err != nil || tz.Name != "CET" {
+		t.Errorf("expected CET zone, got %v, %v", tz, err)
+	}
+}
+
+func TestNewTimezone_ShouldReportCandidatesForAmbiguousAbbreviations(t *testing.T) {
+	// Given an abbreviation used in India, Ireland and Israel
+	_, err := NewTimezone("IST")
+
+	// Then it should fail with every candidate zone
+	var ambiguous *AmbiguousAbbreviationError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected an ambiguity error, got %v", err)
+	}
+	if ambiguous.Abbreviation != "IST" || len(ambiguous.Candidates) != 3 {
+		t.Fatalf("unexpected candidates %+v", ambiguous)
+	}
+	if ambiguous.Candidates[0].Name != "Asia/Kolkata" {
+		t.Errorf("expected Asia/Kolkata first, got %s", ambiguous.Candidates[0].Name)
+	}
+
+	// And the message should name them
+	expected := "ambiguous timezone abbreviation IST: Asia/Kolkata, Europe/Dublin, Asia/Jerusalem"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestTimezonesForAbbreviation_ShouldOnlyListLoadableZones(t *testing.T) {
+	for abbr := range abbreviationZones {
+		for _, tz := range TimezonesForAbbreviation(abbr) {
+			if _, err := tz.Location(); err != nil {
+				t.Errorf("%s maps to unloadable zone %s", abbr, tz.Name)
+			}
+		}
+	}
+	if TimezonesForAbbreviation("XYZ") != nil {
+		t.Error("expected no zones for an unknown abbreviation")
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed tzdata/backward
+var backwardTab string
+
+var (
+	zoneLinksOnce sync.Once
+	zoneLinks     map[string]string // link name → current zone
+	zoneLinksFold map[string]string // lower-cased link name → current zone
+)
+
+// loadZoneLinks parses the embedded backward file, whose columns may be separated by
+// several tabs
+func loadZoneLinks() {
+	zoneLinks = make(map[string]string)
+	zoneLinksFold = make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(backwardTab))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "Link" {
+			continue
+		}
+		zoneLinks[fields[2]] = fields[1]
+		zoneLinksFold[strings.ToLower(fields[2])] = fields[1]
+	}
+}
+
+// CanonicalZoneName returns the current tzdb name for a deprecated or alternative one,
+// e.g. Asia/Kolkata for Asia/Calcutta, and false when name is not such a link
+func CanonicalZoneName(name string) (string, bool) {
+	zoneLinksOnce.Do(loadZoneLinks)
+	canonical, ok := zoneLinks[name]
+	return canonical, ok
+}
+
+// CanonicalZoneNameFold is CanonicalZoneName ignoring case, for names that have been
+// lower-cased such as cache keys
+func CanonicalZoneNameFold(name string) (string, bool) {
+	zoneLinksOnce.Do(loadZoneLinks)
+	canonical, ok := zoneLinksFold[strings.ToLower(name)]
+	return canonical, ok
+}
+
+// Canonical returns the timezone under its current tzdb name, recording the name it
+// was given as its Alias; zones that are not links are returned as they are
+func (tz *Timezone) Canonical() *Timezone {
+	canonical, ok := CanonicalZoneName(tz.Name)
+	if !ok {
+		return tz
+	}
+	return &Timezone{Name: canonical, Alias: tz.Name, Place: tz.Place}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WallClock represents a local time of day, optionally shifted by whole days
+type WallClock struct {
+	Hour      int
+	Minute    int
+	DayOffset int
+}
+
+var dayWords = map[string]int{
+	"yesterday": -1,
+	"today":     0,
+	"tomorrow":  1,
+}
+
+// ParseWallClock parses expressions like "3pm", "9:30", "15:00 tomorrow" or "noon"
+func ParseWallClock(input string) (*WallClock, error) {
+	tokens := strings.Fields(strings.ToLower(input))
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("time cannot be empty")
+	}
+
+	clock, rest, ok := consumeWallClock(tokens)
+	if !ok || len(rest) > 0 {
+		return nil, fmt.Errorf("invalid time: %s", strings.TrimSpace(input))
+	}
+	return clock, nil
+}
+
+// On returns the instant this wall clock time denotes on ref's calendar date in loc
+func (w *WallClock) On(ref time.Time, loc *time.Location) time.Time {
+	ref = ref.In(loc)
+	return time.Date(ref.Year(), ref.Month(), ref.Day()+w.DayOffset, w.Hour, w.Minute, 0, 0, loc)
+}
+
+// String returns the wall clock time in 24-hour notation
+func (w *WallClock) String() string {
+	s := fmt.Sprintf("%02d:%02d", w.Hour, w.Minute)
+	switch {
+	case w.DayOffset == 1:
+		s += " tomorrow"
+	case w.DayOffset == -1:
+		s += " yesterday"
+	case w.DayOffset != 0:
+		s += fmt.Sprintf(" %+dd", w.DayOffset)
+	}
+	return s
+}
+
+// consumeWallClock reads a time of day and day words from the front of tokens
+// and returns the remaining tokens
+func consumeWallClock(tokens []string) (*WallClock, []string, bool) {
+	clock := &WallClock{}
+	foundTime, foundDay := false, false
+
+	for len(tokens) > 0 {
+		tok := tokens[0]
+		if offset, ok := dayWords[tok]; ok && !foundDay {
+			clock.DayOffset = offset
+			foundDay = true
+			tokens = tokens[1:]
+			continue
+		}
+		if foundTime {
+			break
+		}
+
+		// Allow a detached meridiem such as "3 pm"
+		if len(tokens) > 1 && (tokens[1] == "am" || tokens[1] == "pm") {
+			if h, m, ok := parseTimeOfDay(tok + tokens[1]); ok {
+				clock.Hour, clock.Minute = h, m
+				foundTime = true
+				tokens = tokens[2:]
+				continue
+			}
+		}
+		if h, m, ok := parseTimeOfDay(tok); ok {
+			clock.Hour, clock.Minute = h, m
+			foundTime = true
+			tokens = tokens[1:]
+			continue
+		}
+		break
+	}
+
+	if !foundTime {
+		return nil, tokens, false
+	}
+	return clock, tokens, true
+}
+
+// parseTimeOfDay parses a single token such as "3pm", "3:30pm", "15:00" or "noon"
+func parseTimeOfDay(tok string) (hour, minute int, ok bool) {
+	switch tok {
+	case "noon", "midday":
+		return 12, 0, true
+	case "midnight":
+		return 0, 0, true
+	}
+
+	meridiem := ""
+	for _, suffix := range []string{"am", "pm", "a", "p"} {
+		if strings.HasSuffix(tok, suffix) {
+			meridiem = suffix[:1]
+			tok = strings.TrimSuffix(tok, suffix)
+			break
+		}
+	}
+
+	hourPart, minutePart, hasMinutes := strings.Cut(tok, ":")
+	if !hasMinutes {
+		hourPart, minutePart, hasMinutes = strings.Cut(tok, ".")
+	}
+	// Bare numbers are only times when they carry a meridiem
+	if !hasMinutes && meridiem == "" {
+		return 0, 0, false
+	}
+
+	hour, err := strconv.Atoi(hourPart)
+	if err != nil || len(hourPart) > 2 {
+		return 0, 0, false
+	}
+	if hasMinutes {
+		if len(minutePart) != 2 {
+			return 0, 0, false
+		}
+		minute, err = strconv.Atoi(minutePart)
+		if err != nil || minute > 59 {
+			return 0, 0, false
+		}
+	}
+
+	switch meridiem {
+	case "":
+		if hour > 23 {
+			return 0, 0, false
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return 0, 0, false
+		}
+		hour %= 12
+		if meridiem == "p" {
+			hour += 12
+		}
+	}
+	return hour, minute, true
+}
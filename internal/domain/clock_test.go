@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWallClock_ShouldAcceptCommonNotations(t *testing.T) {
+	tests := []struct {
+		input     string
+		hour      int
+		minute    int
+		dayOffset int
+	}{
+		{"3pm", 15, 0, 0},
+		{"3 PM", 15, 0, 0},
+		{"3:30pm", 15, 30, 0},
+		{"12am", 0, 0, 0},
+		{"12pm", 12, 0, 0},
+		{"9:30", 9, 30, 0},
+		{"15:00", 15, 0, 0},
+		{"noon", 12, 0, 0},
+		{"midnight", 0, 0, 0},
+		{"9:30 tomorrow", 9, 30, 1},
+		{"yesterday 8am", 8, 0, -1},
+	}
+
+	for _, test := range tests {
+		clock, err := ParseWallClock(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if clock.Hour != test.hour || clock.Minute != test.minute || clock.DayOffset != test.dayOffset {
+			t.Errorf("for %q, expected %02d:%02d%+d, got %02d:%02d%+d", test.input,
+				test.hour, test.minute, test.dayOffset, clock.Hour, clock.Minute, clock.DayOffset)
+		}
+	}
+}
+
+func TestParseWallClock_ShouldRejectInvalidTimes(t *testing.T) {
+	for _, input := range []string{"", "15", "13pm", "0am", "25:00", "9:60", "9:5", "London"} {
+		if _, err := ParseWallClock(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestWallClock_ShouldResolveOnReferenceDateInLocation(t *testing.T) {
+	// Given a reference instant late in the evening UTC, already the next day in Tokyo
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	ref := time.Date(2026, time.March, 10, 20, 0, 0, 0, time.UTC)
+
+	// When resolving "9am tomorrow" in Tokyo
+	clock := &WallClock{Hour: 9, DayOffset: 1}
+	got := clock.On(ref, tokyo)
+
+	// Then it should use Tokyo's calendar date
+	want := time.Date(2026, time.March, 12, 9, 0, 0, 0, tokyo)
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
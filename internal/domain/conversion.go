@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConversionQuery represents a parsed "<time> <place> in <place>" request
+type ConversionQuery struct {
+	When *RelativeTime // nil means "now"
+	From string
+	To   string
+}
+
+var conversionSeparators = []string{" in ", " to ", " -> ", " → "}
+
+// ParseConversionQuery parses queries like "3pm London in Tokyo", "9:30 tomorrow NYC to Berlin"
+// or "next Monday noon London in Sydney"
+func ParseConversionQuery(query string) (*ConversionQuery, error) {
+	query = strings.Join(strings.Fields(query), " ")
+	if query == "" {
+		return nil, fmt.Errorf("conversion query cannot be empty")
+	}
+
+	// Split on the last separator so place names may contain "in" or "to"
+	cut, sepLen := -1, 0
+	for _, sep := range conversionSeparators {
+		if i := lastIndexFold(query, sep); i > cut {
+			cut, sepLen = i, len(sep)
+		}
+	}
+	if cut < 0 {
+		return nil, fmt.Errorf("expected \"<time> <place> in <place>\", got: %s", query)
+	}
+
+	source := strings.Fields(query[:cut])
+	target := strings.TrimSpace(query[cut+sepLen:])
+	if target == "" {
+		return nil, fmt.Errorf("target place cannot be empty")
+	}
+
+	lowered := make([]string, len(source))
+	for i, tok := range source {
+		lowered[i] = strings.ToLower(tok)
+	}
+
+	var when *RelativeTime
+	if rt, rest, ok := consumeRelativeTime(lowered); ok {
+		when = rt
+		source = source[len(source)-len(rest):]
+		lowered = rest
+	}
+
+	// A trailing day word belongs to the time: "3pm London tomorrow in Tokyo"
+	if when != nil && when.Clock != nil && len(lowered) > 1 {
+		if offset, ok := dayWords[lowered[len(lowered)-1]]; ok && when.Days == 0 {
+			when.Days = offset
+			source = source[:len(source)-1]
+		}
+	}
+
+	from := strings.Join(source, " ")
+	if from == "" {
+		return nil, fmt.Errorf("source place cannot be empty")
+	}
+
+	return &ConversionQuery{
+		When: when,
+		From: from,
+		To:   target,
+	}, nil
+}
+
+// lastIndexFold is strings.LastIndex with ASCII case folding
+func lastIndexFold(s, substr string) int {
+	for i := len(s) - len(substr); i >= 0; i-- {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
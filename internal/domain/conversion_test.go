@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseConversionQuery_ShouldSplitTimeAndPlaces(t *testing.T) {
+	tests := []struct {
+		query     string
+		from      string
+		to        string
+		hour      int
+		minute    int
+		dayOffset int
+	}{
+		{"3pm London in Tokyo", "London", "Tokyo", 15, 0, 0},
+		{"9:30 tomorrow NYC to Berlin", "NYC", "Berlin", 9, 30, 1},
+		{"3pm London tomorrow in Tokyo", "London", "Tokyo", 15, 0, 1},
+		{"10am Salt Lake City in Rio de Janeiro", "Salt Lake City", "Rio de Janeiro", 10, 0, 0},
+		{"8 am Europe/Paris IN Asia/Kolkata", "Europe/Paris", "Asia/Kolkata", 8, 0, 0},
+		{"tomorrow at 21:00 London in Tokyo", "London", "Tokyo", 21, 0, 1},
+		{"next monday noon NYC in Sydney", "NYC", "Sydney", 12, 0, 0},
+	}
+
+	for _, test := range tests {
+		q, err := ParseConversionQuery(test.query)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.query, err)
+			continue
+		}
+		if q.From != test.from || q.To != test.to {
+			t.Errorf("for %q, expected %q → %q, got %q → %q", test.query, test.from, test.to, q.From, q.To)
+		}
+		if q.When == nil || q.When.Clock == nil {
+			t.Errorf("for %q, expected a clock time", test.query)
+			continue
+		}
+		if q.When.Clock.Hour != test.hour || q.When.Clock.Minute != test.minute || q.When.Days != test.dayOffset {
+			t.Errorf("for %q, unexpected time %+v at %s", test.query, q.When, q.When.Clock)
+		}
+	}
+}
+
+func TestParseConversionQuery_ShouldDefaultToNowWithoutTime(t *testing.T) {
+	// Given a query without a time
+	q, err := ParseConversionQuery("London in Tokyo")
+
+	// Then it should parse with no clock
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.When != nil {
+		t.Errorf("expected no time, got %+v", q.When)
+	}
+	if q.From != "London" || q.To != "Tokyo" {
+		t.Errorf("unexpected places %q → %q", q.From, q.To)
+	}
+}
+
+func TestParseConversionQuery_ShouldRejectIncompleteQueries(t *testing.T) {
+	for _, query := range []string{"", "3pm London", "3pm in Tokyo", "3pm London in "} {
+		if _, err := ParseConversionQuery(query); err == nil {
+			t.Errorf("expected error for %q", query)
+		}
+	}
+}
+
+func TestParseConversionQuery_ShouldAcceptRelativeShifts(t *testing.T) {
+	// Given a query with an exact shift
+	q, err := ParseConversionQuery("in 3 hours London in Tokyo")
+
+	// Then the shift should be parsed and the last "in" should split the places
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.When == nil || q.When.Shift != 3*time.Hour {
+		t.Errorf("expected a 3h shift, got %+v", q.When)
+	}
+	if q.From != "London" || q.To != "Tokyo" {
+		t.Errorf("unexpected places %q → %q", q.From, q.To)
+	}
+}
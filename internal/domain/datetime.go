@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LocalDateTime is a calendar date and wall clock time not yet tied to a timezone
+type LocalDateTime struct {
+	Year   int
+	Month  time.Month
+	Day    int
+	Hour   int
+	Minute int
+	Second int
+}
+
+// localDateTimeLayouts are tried against the first field, then the first two fields
+var localDateTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// ParseLocalDateTime parses a leading "2026-03-29 02:30" or "2026-03-29T02:30:00" and
+// returns the rest of the input, typically a place or timezone
+func ParseLocalDateTime(input string) (*LocalDateTime, string, error) {
+	fields := strings.Fields(input)
+	for n := 1; n <= 2 && n <= len(fields); n++ {
+		prefix := strings.Join(fields[:n], " ")
+		for _, layout := range localDateTimeLayouts {
+			t, err := time.Parse(layout, prefix)
+			if err != nil {
+				continue
+			}
+			dt := &LocalDateTime{
+				Year: t.Year(), Month: t.Month(), Day: t.Day(),
+				Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(),
+			}
+			return dt, strings.Join(fields[n:], " "), nil
+		}
+	}
+	return nil, "", fmt.Errorf("invalid date and time: %s (expected YYYY-MM-DD HH:MM)", strings.TrimSpace(input))
+}
+
+// ParseDatePeriod parses a year "2013", month "2013-06" or day "2013-06-01" and returns
+// its first day and the day after it ends, both at midnight
+func ParseDatePeriod(input string) (start, end LocalDateTime, err error) {
+	input = strings.TrimSpace(input)
+	for _, layout := range []struct {
+		layout string
+		years  int
+		months int
+		days   int
+	}{
+		{"2006", 1, 0, 0},
+		{"2006-01", 0, 1, 0},
+		{"2006-01-02", 0, 0, 1},
+	} {
+		t, parseErr := time.Parse(layout.layout, input)
+		if parseErr != nil {
+			continue
+		}
+		next := t.AddDate(layout.years, layout.months, layout.days)
+		start = LocalDateTime{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+		end = LocalDateTime{Year: next.Year(), Month: next.Month(), Day: next.Day()}
+		return start, end, nil
+	}
+	return start, end, fmt.Errorf("invalid date: %s (expected YYYY, YYYY-MM or YYYY-MM-DD)", input)
+}
+
+// In returns the instant this date and time denotes in loc. Unlike time.Date, which
+// silently normalises, it reports wall clock times skipped by a DST gap with a
+// *NonexistentTimeError and times repeated by a DST overlap with an *AmbiguousTimeError
+func (dt *LocalDateTime) In(loc *time.Location) (time.Time, error) {
+	instants := dt.instants(loc)
+	switch len(instants) {
+	case 0:
+		return time.Time{}, &NonexistentTimeError{Local: *dt, Zone: loc.String()}
+	case 1:
+		return instants[0], nil
+	default:
+		return time.Time{}, &AmbiguousTimeError{Local: *dt, Zone: loc.String(), Earlier: instants[0], Later: instants[len(instants)-1]}
+	}
+}
+
+// instants returns every instant whose wall clock in loc reads dt, in order
+func (dt *LocalDateTime) instants(loc *time.Location) []time.Time {
+	wall := time.Date(dt.Year, dt.Month, dt.Day, dt.Hour, dt.Minute, dt.Second, 0, time.UTC)
+
+	// Any offset in effect within a day either side may apply to this wall clock
+	var candidates []time.Time
+	for _, probe := range []time.Time{wall.Add(-24 * time.Hour), wall, wall.Add(24 * time.Hour)} {
+		_, offset := probe.In(loc).Zone()
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		y, m, d := t.Date()
+		if y == dt.Year && m == dt.Month && d == dt.Day && t.Hour() == dt.Hour && t.Minute() == dt.Minute && t.Second() == dt.Second {
+			candidates = append(candidates, t)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+
+	var result []time.Time
+	for _, t := range candidates {
+		if len(result) == 0 || !result[len(result)-1].Equal(t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// String returns the date and time as "2006-01-02 15:04", with seconds when set
+func (dt *LocalDateTime) String() string {
+	s := fmt.Sprintf("%04d-%02d-%02d %02d:%02d", dt.Year, dt.Month, dt.Day, dt.Hour, dt.Minute)
+	if dt.Second != 0 {
+		s += fmt.Sprintf(":%02d", dt.Second)
+	}
+	return s
+}
+
+// NonexistentTimeError reports a wall clock time skipped when clocks go forward
+type NonexistentTimeError struct {
+	Local LocalDateTime
+	Zone  string
+}
+
+func (e *NonexistentTimeError) Error() string {
+	return fmt.Sprintf("%s does not exist in %s (clocks go forward)", &e.Local, e.Zone)
+}
+
+// AmbiguousTimeError reports a wall clock time that occurs twice when clocks go back
+type AmbiguousTimeError struct {
+	Local   LocalDateTime
+	Zone    string
+	Earlier time.Time
+	Later   time.Time
+}
+
+func (e *AmbiguousTimeError) Error() string {
+	_, earlier := e.Earlier.Zone()
+	_, later := e.Later.Zone()
+	return fmt.Sprintf("%s is ambiguous in %s (clocks go back): %s (%s) or %s (%s)", &e.Local, e.Zone,
+		e.Earlier.Format("15:04 MST"), FormatUTCOffset(earlier),
+		e.Later.Format("15:04 MST"), FormatUTCOffset(later))
+}
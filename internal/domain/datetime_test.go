@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLocalDateTime(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		rest     string
+	}{
+		{"2026-03-29 02:30 Europe/London", "2026-03-29 02:30", "Europe/London"},
+		{"2026-03-29T02:30:15 New York", "2026-03-29 02:30:15", "New York"},
+		{"2026-06-10 15:00", "2026-06-10 15:00", ""},
+	}
+
+	for _, test := range tests {
+		dt, rest, err := ParseLocalDateTime(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if dt.String() != test.expected || rest != test.rest {
+			t.Errorf("for %q, expected %s + %q, got %s + %q", test.input, test.expected, test.rest, dt, rest)
+		}
+	}
+
+	for _, input := range []string{"", "tomorrow", "2026-02-30 10:00", "2026-06-10"} {
+		if _, _, err := ParseLocalDateTime(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestLocalDateTime_In(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+
+	// A regular wall clock time maps to one instant
+	dt := &LocalDateTime{Year: 2026, Month: time.June, Day: 10, Hour: 15}
+	got, err := dt.In(london)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	// The spring-forward gap never shows on the wall clock
+	gap := &LocalDateTime{Year: 2026, Month: time.March, Day: 29, Hour: 1, Minute: 30}
+	if _, err := gap.In(london); err == nil {
+		t.Error("expected error for a time inside the DST gap")
+	}
+}
+
+func TestParseDatePeriod(t *testing.T) {
+	tests := []struct {
+		input string
+		start string
+		end   string
+	}{
+		{"2013", "2013-01-01 00:00", "2014-01-01 00:00"},
+		{"2013-12", "2013-12-01 00:00", "2014-01-01 00:00"},
+		{"2013-06-01", "2013-06-01 00:00", "2013-06-02 00:00"},
+	}
+
+	for _, test := range tests {
+		start, end, err := ParseDatePeriod(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if start.String() != test.start || end.String() != test.end {
+			t.Errorf("for %q, expected %s to %s, got %s to %s", test.input, test.start, test.end, start.String(), end.String())
+		}
+	}
+
+	for _, input := range []string{"", "13", "2013-13", "2013-02-30", "tomorrow"} {
+		if _, _, err := ParseDatePeriod(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// DayPeriod classifies a local time by what people there are probably doing
+type DayPeriod int
+
+const (
+	PeriodWorking DayPeriod = iota
+	PeriodEarlyMorning
+	PeriodEvening
+	PeriodNight
+	PeriodWeekend
+	PeriodHoliday
+)
+
+// String returns a short human description of the period
+func (p DayPeriod) String() string {
+	switch p {
+	case PeriodWorking:
+		return "working hours"
+	case PeriodEarlyMorning:
+		return "early morning"
+	case PeriodEvening:
+		return "evening"
+	case PeriodNight:
+		return "probably asleep"
+	case PeriodWeekend:
+		return "weekend"
+	case PeriodHoliday:
+		return "public holiday"
+	default:
+		return "unknown"
+	}
+}
+
+// Schedule describes a typical local day: working hours on working days and a sleeping window
+type Schedule struct {
+	Work  WorkingHours
+	Sleep WorkingHours
+}
+
+// DefaultSchedule works 09:00–17:00 and sleeps 23:00–07:00
+var DefaultSchedule = Schedule{
+	Work:  DefaultWorkingHours,
+	Sleep: WorkingHours{Start: WallClock{Hour: 23}, End: WallClock{Hour: 7}},
+}
+
+// PeriodAt classifies t, read on its own location's wall clock, given the local weekend days
+func (s Schedule) PeriodAt(t time.Time, weekend []time.Weekday) DayPeriod {
+	switch {
+	case s.Sleep.Contains(t):
+		return PeriodNight
+	case containsWeekday(weekend, t.Weekday()):
+		return PeriodWeekend
+	case s.Work.Contains(t):
+		return PeriodWorking
+	case minuteOfDay(t.Hour(), t.Minute()) < minuteOfDay(s.Work.Start.Hour, s.Work.Start.Minute):
+		return PeriodEarlyMorning
+	default:
+		return PeriodEvening
+	}
+}
+
+// PeriodIn classifies t in a timezone, honouring the weekend and public holidays of
+// its principal country
+func (s Schedule) PeriodIn(tz *Timezone, t time.Time) DayPeriod {
+	if loc, err := tz.Location(); err == nil {
+		t = t.In(loc)
+	}
+	if _, ok := tz.Holiday(t); ok && !s.Sleep.Contains(t) {
+		return PeriodHoliday
+	}
+	return s.PeriodAt(t, tz.Weekend())
+}
+
+// Contains reports whether t's wall clock falls inside the window, which may span midnight
+func (w WorkingHours) Contains(t time.Time) bool {
+	now := minuteOfDay(t.Hour(), t.Minute())
+	start := minuteOfDay(w.Start.Hour, w.Start.Minute)
+	end := minuteOfDay(w.End.Hour, w.End.Minute)
+	if start <= end {
+		return now >= start && now < end
+	}
+	return now >= start || now < end
+}
+
+func minuteOfDay(hour, minute int) int {
+	return hour*60 + minute
+}
+
+// DefaultWeekend is the Saturday–Sunday weekend most countries observe
+var DefaultWeekend = []time.Weekday{time.Saturday, time.Sunday}
+
+// countryWeekends lists countries whose weekend differs from DefaultWeekend
+var countryWeekends = map[string][]time.Weekday{
+	// Friday–Saturday across the Gulf and much of the Middle East and North Africa
+	"SA": {time.Friday, time.Saturday},
+	"KW": {time.Friday, time.Saturday},
+	"QA": {time.Friday, time.Saturday},
+	"BH": {time.Friday, time.Saturday},
+	"OM": {time.Friday, time.Saturday},
+	"YE": {time.Friday, time.Saturday},
+	"IQ": {time.Friday, time.Saturday},
+	"JO": {time.Friday, time.Saturday},
+	"SY": {time.Friday, time.Saturday},
+	"EG": {time.Friday, time.Saturday},
+	"LY": {time.Friday, time.Saturday},
+	"DZ": {time.Friday, time.Saturday},
+	"SD": {time.Friday, time.Saturday},
+	"IL": {time.Friday, time.Saturday},
+	"BD": {time.Friday, time.Saturday},
+	"AF": {time.Friday, time.Saturday},
+	// Single-day weekends
+	"IR": {time.Friday},
+	"NP": {time.Saturday},
+}
+
+// WeekendDays returns the weekend days of an ISO 3166 country
+func WeekendDays(countryCode string) []time.Weekday {
+	if days, ok := countryWeekends[strings.ToUpper(countryCode)]; ok {
+		return days
+	}
+	return DefaultWeekend
+}
+
+// Weekend returns the weekend days of the timezone's principal country
+func (tz *Timezone) Weekend() []time.Weekday {
+	if code := tz.CountryCode(); code != "" {
+		return WeekendDays(code)
+	}
+	return DefaultWeekend
+}
+
+func containsWeekday(days []time.Weekday, d time.Weekday) bool {
+	for _, day := range days {
+		if day == d {
+			return true
+		}
+	}
+	return false
+}
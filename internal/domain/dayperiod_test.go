@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_PeriodAt(t *testing.T) {
+	// Wednesday 10 June 2026 and Saturday 13 June 2026
+	tests := []struct {
+		at       time.Time
+		expected DayPeriod
+	}{
+		{time.Date(2026, time.June, 10, 10, 30, 0, 0, time.UTC), PeriodWorking},
+		{time.Date(2026, time.June, 10, 7, 30, 0, 0, time.UTC), PeriodEarlyMorning},
+		{time.Date(2026, time.June, 10, 17, 0, 0, 0, time.UTC), PeriodEvening},
+		{time.Date(2026, time.June, 10, 22, 40, 0, 0, time.UTC), PeriodEvening},
+		{time.Date(2026, time.June, 10, 23, 0, 0, 0, time.UTC), PeriodNight},
+		{time.Date(2026, time.June, 10, 3, 0, 0, 0, time.UTC), PeriodNight},
+		{time.Date(2026, time.June, 13, 10, 30, 0, 0, time.UTC), PeriodWeekend},
+		{time.Date(2026, time.June, 13, 3, 0, 0, 0, time.UTC), PeriodNight},
+	}
+
+	for _, test := range tests {
+		if got := DefaultSchedule.PeriodAt(test.at, DefaultWeekend); got != test.expected {
+			t.Errorf("at %s, expected %s, got %s", test.at.Format("Mon 15:04"), test.expected, got)
+		}
+	}
+}
+
+func TestSchedule_PeriodAt_ShouldHonourConfiguredHours(t *testing.T) {
+	// Given a night-shift schedule
+	work, _ := ParseWorkingHours("22:00-06:00")
+	sleep, _ := ParseWorkingHours("09:00-17:00")
+	schedule := Schedule{Work: work, Sleep: sleep}
+
+	// Then 02:00 on a weekday is working hours and noon is asleep
+	if got := schedule.PeriodAt(time.Date(2026, time.June, 10, 2, 0, 0, 0, time.UTC), DefaultWeekend); got != PeriodWorking {
+		t.Errorf("expected working hours at 02:00, got %s", got)
+	}
+	if got := schedule.PeriodAt(time.Date(2026, time.June, 10, 12, 0, 0, 0, time.UTC), DefaultWeekend); got != PeriodNight {
+		t.Errorf("expected asleep at noon, got %s", got)
+	}
+}
+
+func TestTimezone_Weekend(t *testing.T) {
+	tests := map[string][]time.Weekday{
+		"Asia/Riyadh":   {time.Friday, time.Saturday},
+		"Asia/Qatar":    {time.Friday, time.Saturday},
+		"Europe/London": {time.Saturday, time.Sunday},
+		"Asia/Dubai":    {time.Saturday, time.Sunday},
+		"UTC+05:30":     {time.Saturday, time.Sunday},
+	}
+
+	for name, expected := range tests {
+		tz, err := NewTimezone(name)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", name, err)
+		}
+		got := tz.Weekend()
+		if len(got) != len(expected) || got[0] != expected[0] || got[len(got)-1] != expected[len(expected)-1] {
+			t.Errorf("for %s, expected %v, got %v", name, expected, got)
+		}
+	}
+
+	// Friday is a working day in London but the weekend in Riyadh
+	friday := time.Date(2026, time.June, 12, 11, 0, 0, 0, time.UTC)
+	riyadh, _ := NewTimezone("Asia/Riyadh")
+	if got := DefaultSchedule.PeriodAt(friday, riyadh.Weekend()); got != PeriodWeekend {
+		t.Errorf("expected weekend on Friday in Riyadh, got %s", got)
+	}
+}
+
+func TestSchedule_PeriodIn_ShouldHonourPublicHolidays(t *testing.T) {
+	tokyo, _ := NewTimezone("Asia/Tokyo")
+
+	// Noon on Showa Day, Wednesday 29 April 2026, is a holiday but 02:00 is still night
+	if got := DefaultSchedule.PeriodIn(tokyo, time.Date(2026, time.April, 29, 3, 0, 0, 0, time.UTC)); got != PeriodHoliday {
+		t.Errorf("expected public holiday, got %s", got)
+	}
+	if got := DefaultSchedule.PeriodIn(tokyo, time.Date(2026, time.April, 28, 17, 0, 0, 0, time.UTC)); got != PeriodNight {
+		t.Errorf("expected night, got %s", got)
+	}
+	if got := DefaultSchedule.PeriodIn(tokyo, time.Date(2026, time.April, 30, 3, 0, 0, 0, time.UTC)); got != PeriodWorking {
+		t.Errorf("expected working hours the next day, got %s", got)
+	}
+}
@@ -28,9 +28,9 @@ func TestTimezone_ShouldHandleComplexCityNames(t *testing.T) {
 		t.Fatalf("Expected complex timezone to be valid, got error: %v", err)
 	}
 	
-	// And extract the middle part (current implementation behavior)
-	if tz.City() != "Argentina" {
-		t.Errorf("Expected city 'Argentina', got '%s'", tz.City())
+	// And use the tzdb exemplar city rather than the region
+	if tz.City() != "Buenos Aires" {
+		t.Errorf("Expected city 'Buenos Aires', got '%s'", tz.City())
 	}
 }
 
@@ -0,0 +1,213 @@
+package domain
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed holidaydata/holidays.tab
+var holidaysTab string
+
+// Holiday is a nationwide public holiday on a calendar date
+type Holiday struct {
+	CountryCode string
+	Name        string
+	Year        int
+	Month       time.Month
+	Day         int
+}
+
+// String returns the holiday name
+func (h *Holiday) String() string {
+	return h.Name
+}
+
+// holidayRule is one row of holidays.tab; see the comments there for the notation
+type holidayRule struct {
+	name    string
+	easter  bool
+	offset  int // days from Easter Sunday
+	month   time.Month
+	day     int          // day of the month, or the bound for "Mon>=8" and "Mon<=24"
+	weekday time.Weekday // for weekday rules
+	kind    byte         // 0 fixed day, '>' on or after, '<' on or before, 'L' last in month
+}
+
+var (
+	holidayRulesOnce sync.Once
+	holidayRules     map[string][]holidayRule
+)
+
+var ruleMonths = map[string]time.Month{
+	"Jan": time.January, "Feb": time.February, "Mar": time.March, "Apr": time.April,
+	"May": time.May, "Jun": time.June, "Jul": time.July, "Aug": time.August,
+	"Sep": time.September, "Oct": time.October, "Nov": time.November, "Dec": time.December,
+}
+
+var ruleWeekdays = map[string]time.Weekday{
+	"Sun": time.Sunday, "Mon": time.Monday, "Tue": time.Tuesday, "Wed": time.Wednesday,
+	"Thu": time.Thursday, "Fri": time.Friday, "Sat": time.Saturday,
+}
+
+func loadHolidayRules() {
+	holidayRules = make(map[string][]holidayRule)
+	eachTabRow(holidaysTab, func(fields []string) {
+		if len(fields) < 4 {
+			return
+		}
+		rule, err := parseHolidayRule(fields[1], fields[2])
+		if err != nil {
+			return
+		}
+		rule.name = fields[3]
+		holidayRules[fields[0]] = append(holidayRules[fields[0]], rule)
+	})
+}
+
+// parseHolidayRule parses the month and day columns of holidays.tab
+func parseHolidayRule(month, day string) (holidayRule, error) {
+	if month == "Easter" {
+		offset, err := strconv.Atoi(day)
+		if err != nil {
+			return holidayRule{}, fmt.Errorf("invalid Easter offset: %s", day)
+		}
+		return holidayRule{easter: true, offset: offset}, nil
+	}
+
+	m, ok := ruleMonths[month]
+	if !ok {
+		return holidayRule{}, fmt.Errorf("invalid month: %s", month)
+	}
+	rule := holidayRule{month: m}
+
+	if name, ok := strings.CutPrefix(day, "last"); ok {
+		wd, ok := ruleWeekdays[name]
+		if !ok {
+			return holidayRule{}, fmt.Errorf("invalid day: %s", day)
+		}
+		rule.kind, rule.weekday = 'L', wd
+		return rule, nil
+	}
+
+	if len(day) > 5 && (day[3:5] == ">=" || day[3:5] == "<=") {
+		wd, ok := ruleWeekdays[day[:3]]
+		bound, err := strconv.Atoi(day[5:])
+		if !ok || err != nil {
+			return holidayRule{}, fmt.Errorf("invalid day: %s", day)
+		}
+		rule.kind, rule.weekday, rule.day = day[3], wd, bound
+		return rule, nil
+	}
+
+	n, err := strconv.Atoi(day)
+	if err != nil || n < 1 || n > 31 {
+		return holidayRule{}, fmt.Errorf("invalid day: %s", day)
+	}
+	rule.day = n
+	return rule, nil
+}
+
+// date returns the rule's date in year as midnight UTC
+func (r holidayRule) date(year int) time.Time {
+	if r.easter {
+		month, day := Easter(year)
+		return time.Date(year, month, day+r.offset, 0, 0, 0, 0, time.UTC)
+	}
+
+	switch r.kind {
+	case '>':
+		d := time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+		return d.AddDate(0, 0, (int(r.weekday)-int(d.Weekday())+7)%7)
+	case '<':
+		d := time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+		return d.AddDate(0, 0, -((int(d.Weekday()) - int(r.weekday) + 7) % 7))
+	case 'L':
+		d := time.Date(year, r.month+1, 0, 0, 0, 0, 0, time.UTC)
+		return d.AddDate(0, 0, -((int(d.Weekday()) - int(r.weekday) + 7) % 7))
+	default:
+		return time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Easter returns the date of Gregorian Easter Sunday, using the anonymous Gregorian algorithm
+func Easter(year int) (time.Month, int) {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Month(month), day
+}
+
+// HolidaysIn returns a country's public holidays in a year, in date order
+func HolidaysIn(countryCode string, year int) []Holiday {
+	holidayRulesOnce.Do(loadHolidayRules)
+	code := strings.ToUpper(countryCode)
+
+	var holidays []Holiday
+	for _, rule := range holidayRules[code] {
+		d := rule.date(year)
+		if d.Year() != year {
+			continue
+		}
+		holidays = append(holidays, Holiday{CountryCode: code, Name: rule.name, Year: year, Month: d.Month(), Day: d.Day()})
+	}
+	sort.SliceStable(holidays, func(i, j int) bool {
+		if holidays[i].Month != holidays[j].Month {
+			return holidays[i].Month < holidays[j].Month
+		}
+		return holidays[i].Day < holidays[j].Day
+	})
+	return holidays
+}
+
+// HolidayOn returns the country's public holiday on t's calendar date, read on t's own
+// location's wall clock. When two holidays coincide the first listed is returned
+func HolidayOn(countryCode string, t time.Time) (*Holiday, bool) {
+	holidayRulesOnce.Do(loadHolidayRules)
+	year, month, day := t.Date()
+	for _, rule := range holidayRules[strings.ToUpper(countryCode)] {
+		d := rule.date(year)
+		if d.Month() == month && d.Day() == day && d.Year() == year {
+			return &Holiday{CountryCode: strings.ToUpper(countryCode), Name: rule.name, Year: year, Month: month, Day: day}, true
+		}
+	}
+	return nil, false
+}
+
+// HolidayCountry returns the country whose holidays apply: the geocoded location's
+// country when known, otherwise the timezone's principal country
+func HolidayCountry(tz *Timezone, loc *Location) string {
+	if loc != nil && loc.CountryCode != "" {
+		return strings.ToUpper(loc.CountryCode)
+	}
+	if tz == nil {
+		return ""
+	}
+	return tz.CountryCode()
+}
+
+// Holiday returns the public holiday on t's local calendar date in the zone's country,
+// see HolidayCountry, if any
+func (tz *Timezone) Holiday(t time.Time) (*Holiday, bool) {
+	code := HolidayCountry(tz, tz.Place)
+	if code == "" {
+		return nil, false
+	}
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, false
+	}
+	return HolidayOn(code, t.In(loc))
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	tests := map[int]string{
+		2000: "Apr 23",
+		2019: "Apr 21",
+		2024: "Mar 31",
+		2025: "Apr 20",
+		2026: "Apr 5",
+		2027: "Mar 28",
+		2038: "Apr 25",
+	}
+
+	for year, expected := range tests {
+		month, day := Easter(year)
+		if got := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("Jan 2"); got != expected {
+			t.Errorf("for %d, expected %s, got %s", year, expected, got)
+		}
+	}
+}
+
+func TestHolidayOn_ShouldApplyEveryRuleKind(t *testing.T) {
+	tests := []struct {
+		country  string
+		date     string
+		expected string
+	}{
+		{"JP", "2026-04-29", "Showa Day"},
+		{"jp", "2026-01-12", "Coming of Age Day"},
+		{"US", "2026-11-26", "Thanksgiving Day"},
+		{"US", "2026-05-25", "Memorial Day"},
+		{"CA", "2026-05-18", "Victoria Day"},
+		{"SE", "2026-06-20", "Midsummer Day"},
+		{"SE", "2026-10-31", "All Saints' Day"},
+		{"GB", "2026-04-03", "Good Friday"},
+		{"DE", "2026-05-25", "Whit Monday"},
+		{"AT", "2026-06-04", "Corpus Christi"},
+	}
+
+	for _, test := range tests {
+		date, _ := time.Parse("2006-01-02", test.date)
+		holiday, ok := HolidayOn(test.country, date)
+		if !ok {
+			t.Errorf("expected %s to be a holiday in %s", test.date, test.country)
+			continue
+		}
+		if holiday.Name != test.expected {
+			t.Errorf("on %s in %s, expected %s, got %s", test.date, test.country, test.expected, holiday.Name)
+		}
+	}
+
+	for _, test := range []struct{ country, date string }{
+		{"JP", "2026-04-28"},
+		{"US", "2026-04-03"},
+		{"ZZ", "2026-01-01"},
+		{"", "2026-01-01"},
+	} {
+		date, _ := time.Parse("2006-01-02", test.date)
+		if holiday, ok := HolidayOn(test.country, date); ok {
+			t.Errorf("expected no holiday on %s in %q, got %s", test.date, test.country, holiday)
+		}
+	}
+}
+
+func TestHolidaysIn_ShouldReturnHolidaysInDateOrder(t *testing.T) {
+	holidays := HolidaysIn("GB", 2026)
+	if len(holidays) != 8 {
+		t.Fatalf("expected 8 holidays, got %d: %v", len(holidays), holidays)
+	}
+	if holidays[1].Name != "Good Friday" || holidays[2].Name != "Easter Monday" {
+		t.Errorf("expected Easter holidays after New Year's Day, got %v", holidays[:3])
+	}
+	for i := 1; i < len(holidays); i++ {
+		prev, cur := holidays[i-1], holidays[i]
+		if cur.Month < prev.Month || (cur.Month == prev.Month && cur.Day < prev.Day) {
+			t.Errorf("holidays out of order: %s before %s", prev.Name, cur.Name)
+		}
+	}
+}
+
+func TestTimezone_Holiday_ShouldUseLocalDate(t *testing.T) {
+	tokyo, _ := NewTimezone("Asia/Tokyo")
+
+	// 16:00 UTC on 28 April is already 01:00 on Showa Day in Tokyo
+	holiday, ok := tokyo.Holiday(time.Date(2026, time.April, 28, 16, 0, 0, 0, time.UTC))
+	if !ok || holiday.Name != "Showa Day" || holiday.CountryCode != "JP" {
+		t.Errorf("expected Showa Day in Japan, got %v", holiday)
+	}
+
+	utc, _ := NewTimezone("UTC")
+	if _, ok := utc.Holiday(time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)); ok {
+		t.Error("expected no holidays for a zone without a country")
+	}
+}
+
+func TestHolidayCountry_ShouldPreferGeocodedCountry(t *testing.T) {
+	zurich, _ := NewTimezone("Europe/Zurich")
+	if got := HolidayCountry(zurich, nil); got != "CH" {
+		t.Errorf("expected the zone's principal country CH, got %q", got)
+	}
+
+	// Europe/Zurich also covers Liechtenstein and parts of Germany
+	konstanz := &Location{Name: "Konstanz", Latitude: 47.66, Longitude: 9.18, CountryCode: "de"}
+	if got := HolidayCountry(zurich, konstanz); got != "DE" {
+		t.Errorf("expected the geocoded country DE, got %q", got)
+	}
+}
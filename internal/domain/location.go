@@ -1,12 +1,21 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
 
 // Location represents a geographic location
 type Location struct {
-	Name      string
-	Latitude  float64
-	Longitude float64
+	Name        string
+	Latitude    float64
+	Longitude   float64
+	CountryCode string // ISO 3166 code when the geocoder reports one
+	DisplayName string // the full name telling candidates apart, e.g. "Paris, United States"
+	Source      string // the geocoder that found the location, e.g. "offline"
 }
 
 // NewLocation creates a new Location
@@ -14,10 +23,10 @@ func NewLocation(name string, lat, lng float64) (*Location, error) {
 	if name == "" {
 		return nil, fmt.Errorf("location name cannot be empty")
 	}
-	if lat < -90 || lat > 90 {
+	if math.IsNaN(lat) || math.IsInf(lat, 0) || lat < -90 || lat > 90 {
 		return nil, fmt.Errorf("invalid latitude: %f", lat)
 	}
-	if lng < -180 || lng > 180 {
+	if math.IsNaN(lng) || math.IsInf(lng, 0) || lng < -180 || lng > 180 {
 		return nil, fmt.Errorf("invalid longitude: %f", lng)
 	}
 
@@ -28,7 +37,203 @@ func NewLocation(name string, lat, lng float64) (*Location, error) {
 	}, nil
 }
 
+// placeNameFolder folds the accented and special letters found in place names to ASCII
+var placeNameFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ā", "a", "ă", "a", "ą", "a",
+	"æ", "ae",
+	"ç", "c", "ć", "c", "č", "c",
+	"ď", "d", "đ", "d", "ð", "d",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ē", "e", "ė", "e", "ę", "e", "ě", "e",
+	"ğ", "g", "ģ", "g",
+	"í", "i", "ì", "i", "î", "i", "ï", "i", "ī", "i", "ı", "i", "\u0307", "",
+	"ķ", "k",
+	"ł", "l", "ļ", "l", "ľ", "l",
+	"ñ", "n", "ń", "n", "ň", "n", "ņ", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ō", "o", "ő", "o",
+	"œ", "oe",
+	"ř", "r",
+	"ś", "s", "š", "s", "ş", "s", "ș", "s",
+	"ť", "t", "ţ", "t", "ț", "t",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ū", "u", "ů", "u", "ű", "u",
+	"ý", "y", "ÿ", "y",
+	"ź", "z", "ż", "z", "ž", "z",
+	"ß", "ss", "þ", "th",
+)
+
+// FoldPlaceName lower-cases a place name, folds its accents to ASCII and collapses
+// whitespace, so "São Paulo", "SAO  PAULO" and "sao paulo" compare equal
+func FoldPlaceName(s string) string {
+	s = placeNameFolder.Replace(strings.ToLower(s))
+	return strings.Join(strings.Fields(s), " ")
+}
+
 // String returns the location name
 func (l *Location) String() string {
 	return l.Name
-}
\ No newline at end of file
+}
+
+// Coordinates returns the location as decimal "latitude,longitude", e.g. "48.858400,2.294500"
+func (l *Location) Coordinates() string {
+	return fmt.Sprintf("%.6f,%.6f", l.Latitude, l.Longitude)
+}
+
+// ParseCoordinates parses coordinates in the notations people paste: decimal
+// "48.8584,2.2945" or "48.8584 2.2945", degrees, minutes and seconds such as
+// 40°42′46″N 74°00′22″W, and geo: URIs (RFC 5870) such as geo:40.7128,-74.006;u=35
+func ParseCoordinates(input string) (*Location, error) {
+	input = strings.TrimSpace(input)
+	lat, lng, ok := parseGeoURI(input)
+	if !ok {
+		lat, lng, ok = parseDecimalCoordinates(input)
+	}
+	if !ok {
+		lat, lng, ok = parseDMSCoordinates(input)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid coordinates: %s", input)
+	}
+	location, err := NewLocation(input, lat, lng)
+	if err != nil {
+		return nil, &CoordinatesRangeError{Input: input, Err: err}
+	}
+	return location, nil
+}
+
+// CoordinatesRangeError reports input written as coordinates that lie off the globe,
+// such as 91,0, so it is not mistaken for a place name
+type CoordinatesRangeError struct {
+	Input string
+	Err   error // the latitude or longitude out of range
+}
+
+func (e *CoordinatesRangeError) Error() string {
+	return fmt.Sprintf("coordinates out of range: %s (%v)", e.Input, e.Err)
+}
+
+func (e *CoordinatesRangeError) Unwrap() error {
+	return e.Err
+}
+
+// decimalDegrees matches a signed decimal number of degrees, leaving out the NaN, Inf
+// and hexadecimal forms strconv.ParseFloat also accepts
+var decimalDegrees = regexp.MustCompile(`^[+-]?(?:\d+(?:\.\d*)?|\.\d+)$`)
+
+// parseDecimalCoordinates parses a latitude and longitude separated by a comma or spaces
+func parseDecimalCoordinates(input string) (float64, float64, bool) {
+	var fields []string
+	if strings.Contains(input, ",") {
+		fields = strings.Split(input, ",")
+	} else {
+		fields = strings.Fields(input)
+	}
+	if len(fields) != 2 {
+		return 0, 0, false
+	}
+	for i := range fields {
+		if fields[i] = strings.TrimSpace(fields[i]); !decimalDegrees.MatchString(fields[i]) {
+			return 0, 0, false
+		}
+	}
+	lat, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	lng, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return lat, lng, true
+}
+
+// parseGeoURI parses "geo:latitude,longitude" with an optional altitude, parameters
+// such as ";u=35" and a query such as "?z=12", all of which are ignored
+func parseGeoURI(input string) (float64, float64, bool) {
+	if len(input) < 4 || !strings.EqualFold(input[:4], "geo:") {
+		return 0, 0, false
+	}
+	path := input[4:]
+	if i := strings.IndexAny(path, ";?"); i >= 0 {
+		path = path[:i]
+	}
+	fields := strings.Split(path, ",")
+	if len(fields) == 3 {
+		if _, err := strconv.ParseFloat(fields[2], 64); err != nil {
+			return 0, 0, false
+		}
+		fields = fields[:2]
+	}
+	if len(fields) != 2 || strings.ContainsAny(path, " \t") {
+		return 0, 0, false
+	}
+	return parseDecimalCoordinates(strings.Join(fields, ","))
+}
+
+// dmsDegrees matches degrees with optional minutes and seconds, e.g. 40°42′46.1″ or 40°42.767′
+const dmsDegrees = `(\d+(?:\.\d+)?)\s*(?:°|º|˚)?\s*` +
+	`(?:(\d+(?:\.\d+)?)\s*(?:'|′|’)\s*)?` +
+	`(?:(\d+(?:\.\d+)?)\s*(?:"|″|”|''|′′)\s*)?`
+
+// dmsHemisphereAfter and dmsHemisphereBefore match one coordinate marked with its
+// hemisphere, as in 40°42′46″N or N 40°42.767′, and the separator after it
+var (
+	dmsHemisphereAfter  = regexp.MustCompile(`^\s*` + dmsDegrees + `([NSEWnsew])\s*[,;]?`)
+	dmsHemisphereBefore = regexp.MustCompile(`^\s*([NSEWnsew])\s*` + dmsDegrees + `[,;]?`)
+)
+
+// parseDMSCoordinates parses a latitude and longitude in degrees, minutes and seconds,
+// each marked with its hemisphere, in either order
+func parseDMSCoordinates(input string) (float64, float64, bool) {
+	first, hemi1, rest, ok := parseDMSCoordinate(input)
+	if !ok {
+		return 0, 0, false
+	}
+	second, hemi2, rest, ok := parseDMSCoordinate(rest)
+	if !ok || strings.TrimSpace(rest) != "" {
+		return 0, 0, false
+	}
+	switch {
+	case strings.ContainsRune("NS", hemi1) && strings.ContainsRune("EW", hemi2):
+		return first, second, true
+	case strings.ContainsRune("EW", hemi1) && strings.ContainsRune("NS", hemi2):
+		return second, first, true
+	}
+	return 0, 0, false
+}
+
+// parseDMSCoordinate parses the coordinate at the start of input into signed decimal
+// degrees, returning its hemisphere and the rest of the input
+func parseDMSCoordinate(input string) (float64, rune, string, bool) {
+	var m []string // the whole match, hemisphere, degrees, minutes and seconds
+	if match := dmsHemisphereAfter.FindStringSubmatch(input); match != nil {
+		m = []string{match[0], match[4], match[1], match[2], match[3]}
+	} else if match := dmsHemisphereBefore.FindStringSubmatch(input); match != nil {
+		m = match
+	} else {
+		return 0, 0, "", false
+	}
+	hemisphere := rune(strings.ToUpper(m[1])[0])
+
+	degrees, _ := strconv.ParseFloat(m[2], 64)
+	for i, unit := range []float64{60, 3600} {
+		part := m[3+i]
+		if part == "" {
+			continue
+		}
+		if strings.Contains(m[2+i], ".") {
+			return 0, 0, "", false // only the last part may have a fraction
+		}
+		value, _ := strconv.ParseFloat(part, 64)
+		if value >= 60 {
+			return 0, 0, "", false
+		}
+		degrees += value / unit
+	}
+	if m[3] == "" && m[4] != "" {
+		return 0, 0, "", false // seconds without minutes
+	}
+
+	if hemisphere == 'S' || hemisphere == 'W' {
+		degrees = -degrees
+	}
+	return degrees, hemisphere, input[len(m[0]):], true
+}
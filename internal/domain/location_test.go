@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"math"
 	"testing"
 )
 
@@ -42,9 +44,129 @@ func TestNewLocation_InvalidLongitude(t *testing.T) {
 	}
 }
 
+func TestNewLocation_ShouldRejectNaNAndInfinity(t *testing.T) {
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if loc, err := NewLocation("Test", value, 0); err == nil {
+			t.Errorf("expected error for latitude %f, got %+v", value, loc)
+		}
+		if loc, err := NewLocation("Test", 0, value); err == nil {
+			t.Errorf("expected error for longitude %f, got %+v", value, loc)
+		}
+	}
+}
+
 func TestNewLocation_EmptyName(t *testing.T) {
 	_, err := NewLocation("", 0, 0)
 	if err == nil {
 		t.Errorf("expected error for empty name")
 	}
-}
\ No newline at end of file
+}
+
+func TestParseCoordinates(t *testing.T) {
+	loc, err := ParseCoordinates(" 48.8584, 2.2945 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loc.Latitude != 48.8584 || loc.Longitude != 2.2945 || loc.Coordinates() != "48.858400,2.294500" {
+		t.Errorf("unexpected location %+v", loc)
+	}
+
+	for _, input := range []string{"", "48.8584", "north,east", "91,0", "0,181"} {
+		if _, err := ParseCoordinates(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestParseCoordinates_Notations(t *testing.T) {
+	tests := []struct {
+		input    string
+		lat, lng float64
+	}{
+		{"40.71,-74.00", 40.71, -74.00},
+		{"40.71 -74.00", 40.71, -74.00},
+		{"geo:40.7128,-74.006", 40.7128, -74.006},
+		{"GEO:40.7128,-74.006,12.5;u=35?z=12", 40.7128, -74.006},
+		{"40°42′46″N 74°00′22″W", 40.712778, -74.006111},
+		{`40°42'46"N, 74°0'22"W`, 40.712778, -74.006111},
+		{"33°51′35.9″S 151°12′40″E", -33.859972, 151.211111},
+		{"N 40°42.767′ W 74°0.367′", 40.712783, -74.006117},
+		{"40.7128° N, 74.0060° W", 40.7128, -74.006},
+		{"74°00′22″W 40°42′46″N", 40.712778, -74.006111},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			// When parsing coordinates written in a common notation
+			loc, err := ParseCoordinates(tt.input)
+
+			// Then they should become signed decimal degrees
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if math.Abs(loc.Latitude-tt.lat) > 1e-6 || math.Abs(loc.Longitude-tt.lng) > 1e-6 {
+				t.Errorf("ParseCoordinates(%q) = %f,%f; want %f,%f", tt.input, loc.Latitude, loc.Longitude, tt.lat, tt.lng)
+			}
+		})
+	}
+
+	invalid := []string{
+		"Paris",
+		"10 Downing Street",
+		"geo:40.71",
+		"geo:40.71,-74.00,high",
+		"40°42′46″N",
+		"40°42′46″N 74°00′22″N",
+		"40°61′N 74°W",
+		"40°42′61″N 74°W",
+		"40.5°42′N 74°W",
+		"40°42′46″ 74°00′22″",
+		"91°N 74°W",
+	}
+	for _, input := range invalid {
+		if loc, err := ParseCoordinates(input); err == nil {
+			t.Errorf("expected error for %q, got %+v", input, loc)
+		}
+	}
+}
+
+func TestParseCoordinates_ShouldReportCoordinatesOffTheGlobe(t *testing.T) {
+	// Given coordinates in each notation with a latitude or longitude out of range
+	for _, input := range []string{"91,0", "0 -181", "91°N 74°W", "geo:0,181"} {
+		// When parsing them
+		_, err := ParseCoordinates(input)
+
+		// Then the range should be reported, not a failure to read coordinates
+		var outOfRange *CoordinatesRangeError
+		if !errors.As(err, &outOfRange) {
+			t.Errorf("expected CoordinatesRangeError for %q, got %v", input, err)
+		}
+	}
+
+	// But numbers strconv reads that are not degrees should not be coordinates at all
+	for _, input := range []string{"NaN,0", "0,Inf", "-infinity 0", "0x1p-2,0", "1_0,0"} {
+		_, err := ParseCoordinates(input)
+		var outOfRange *CoordinatesRangeError
+		if err == nil || errors.As(err, &outOfRange) {
+			t.Errorf("expected %q not to read as coordinates, got %v", input, err)
+		}
+	}
+}
+
+func TestFoldPlaceName(t *testing.T) {
+	tests := map[string]string{
+		"São Paulo":  "sao paulo",
+		"  ŁÓDŹ ":    "lodz",
+		"İstanbul":   "istanbul",
+		"Malmö":      "malmo",
+		"Reykjavík":  "reykjavik",
+		"Straßburg":  "strassburg",
+		"Chişinău":   "chisinau",
+		"SAO  PAULO": "sao paulo",
+	}
+	for input, want := range tests {
+		if got := FoldPlaceName(input); got != want {
+			t.Errorf("FoldPlaceName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatUTCOffset renders an offset in seconds east of UTC as "UTC+05:30"
+func FormatUTCOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("UTC%s%02d:%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// Fixed offsets are bounded to those in use, UTC-12:00 to UTC+14:00
+const (
+	minUTCOffset = -12 * 3600
+	maxUTCOffset = 14 * 3600
+)
+
+// ParseUTCOffset parses offset notations like "UTC+5:30", "GMT-3", "+0800" or "UTC−03"
+// and returns the offset in seconds east of UTC
+func ParseUTCOffset(input string) (int, bool) {
+	s := strings.ReplaceAll(strings.Join(strings.Fields(input), ""), "−", "-")
+	for _, prefix := range []string{"UTC", "GMT"} {
+		if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+			s = s[len(prefix):]
+			break
+		}
+	}
+	if len(s) < 2 || (s[0] != '+' && s[0] != '-') {
+		return 0, false
+	}
+
+	sign, digits := 1, s[1:]
+	if s[0] == '-' {
+		sign = -1
+	}
+
+	hourPart, minutePart, hasColon := strings.Cut(digits, ":")
+	switch {
+	case hasColon:
+		if len(minutePart) != 2 {
+			return 0, false
+		}
+	case len(digits) > 2:
+		// "+0800" or "+530"
+		hourPart, minutePart = digits[:len(digits)-2], digits[len(digits)-2:]
+	}
+	if !isDigits(hourPart) || len(hourPart) > 2 || (minutePart != "" && !isDigits(minutePart)) {
+		return 0, false
+	}
+
+	hours, _ := strconv.Atoi(hourPart)
+	minutes := 0
+	if minutePart != "" {
+		minutes, _ = strconv.Atoi(minutePart)
+	}
+	if minutes > 59 {
+		return 0, false
+	}
+
+	seconds := sign * (hours*3600 + minutes*60)
+	if seconds < minUTCOffset || seconds > maxUTCOffset {
+		return 0, false
+	}
+	return seconds, true
+}
+
+// offsetAbbreviation renders an offset the way tzdb abbreviates numeric zones, e.g. "+0530" or "-03"
+func offsetAbbreviation(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	if seconds%3600 == 0 {
+		return fmt.Sprintf("%s%02d", sign, seconds/3600)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// RelativeOffset describes how far a target timezone is from a home timezone at one instant
+type RelativeOffset struct {
+	Seconds  int // target UTC offset minus home UTC offset
+	DayDelta int // target local calendar date minus home local calendar date
+}
+
+// NewRelativeOffset compares the target and home timezones at instant t
+func NewRelativeOffset(t time.Time, home, target *time.Location) RelativeOffset {
+	homeTime, targetTime := t.In(home), t.In(target)
+	_, homeOffset := homeTime.Zone()
+	_, targetOffset := targetTime.Zone()
+
+	homeDate := time.Date(homeTime.Year(), homeTime.Month(), homeTime.Day(), 0, 0, 0, 0, time.UTC)
+	targetDate := time.Date(targetTime.Year(), targetTime.Month(), targetTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	return RelativeOffset{
+		Seconds:  targetOffset - homeOffset,
+		DayDelta: int(targetDate.Sub(homeDate).Hours() / 24),
+	}
+}
+
+// String renders the offset like "+13h, tomorrow" or "−5:30, same day"
+func (r RelativeOffset) String() string {
+	var day string
+	switch r.DayDelta {
+	case -1:
+		day = "yesterday"
+	case 0:
+		day = "same day"
+	case 1:
+		day = "tomorrow"
+	default:
+		day = fmt.Sprintf("%+d days", r.DayDelta)
+	}
+
+	if r.Seconds == 0 {
+		return "same time, " + day
+	}
+
+	sign, seconds := "+", r.Seconds
+	if seconds < 0 {
+		sign, seconds = "−", -seconds
+	}
+	hours, minutes := seconds/3600, seconds%3600/60
+	if minutes == 0 {
+		return fmt.Sprintf("%s%dh, %s", sign, hours, day)
+	}
+	return fmt.Sprintf("%s%d:%02d, %s", sign, hours, minutes, day)
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatUTCOffset(t *testing.T) {
+	tests := []struct {
+		seconds  int
+		expected string
+	}{
+		{0, "UTC+00:00"},
+		{9 * 3600, "UTC+09:00"},
+		{5*3600 + 30*60, "UTC+05:30"},
+		{5*3600 + 45*60, "UTC+05:45"},
+		{-(3*3600 + 30*60), "UTC-03:30"},
+		{-10 * 3600, "UTC-10:00"},
+	}
+
+	for _, test := range tests {
+		if got := FormatUTCOffset(test.seconds); got != test.expected {
+			t.Errorf("for %d, expected %s, got %s", test.seconds, test.expected, got)
+		}
+	}
+}
+
+func TestParseUTCOffset(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+		ok       bool
+	}{
+		{"UTC+5:30", 5*3600 + 30*60, true},
+		{"utc+05:30", 5*3600 + 30*60, true},
+		{"GMT-3", -3 * 3600, true},
+		{"+0800", 8 * 3600, true},
+		{"+08:00", 8 * 3600, true},
+		{"+530", 5*3600 + 30*60, true},
+		{"UTC −03", -3 * 3600, true},
+		{"UTC + 5:45", 5*3600 + 45*60, true},
+		{"UTC+14", 14 * 3600, true},
+		{"UTC-12", -12 * 3600, true},
+		{"UTC+0", 0, true},
+		{"UTC", 0, false},
+		{"UTC+15", 0, false},
+		{"UTC+5:60", 0, false},
+		{"UTC+5:3", 0, false},
+		{"+08000", 0, false},
+		{"UTC++5", 0, false},
+		{"Europe/London", 0, false},
+		{"0800", 0, false},
+	}
+
+	for _, test := range tests {
+		got, ok := ParseUTCOffset(test.input)
+		if ok != test.ok || got != test.expected {
+			t.Errorf("ParseUTCOffset(%q) = %d, %v, want %d, %v", test.input, got, ok, test.expected, test.ok)
+		}
+	}
+}
+
+func TestRelativeOffset_ShouldDescribeOffsetAndDayBoundary(t *testing.T) {
+	auckland, _ := time.LoadLocation("Pacific/Auckland")
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	kathmandu, _ := time.LoadLocation("Asia/Kathmandu")
+	newYork, _ := time.LoadLocation("America/New_York")
+	london, _ := time.LoadLocation("Europe/London")
+	stJohns, _ := time.LoadLocation("America/St_Johns")
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		home     *time.Location
+		target   *time.Location
+		expected string
+	}{
+		{"ahead across midnight", time.Date(2026, time.January, 15, 20, 0, 0, 0, time.UTC), london, auckland, "+13h, tomorrow"},
+		{"half hour behind", time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC), kolkata, london, "−5:30, same day"},
+		{"quarter hour", time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC), kolkata, kathmandu, "+0:15, same day"},
+		{"behind across midnight", time.Date(2026, time.January, 15, 2, 0, 0, 0, time.UTC), london, newYork, "−5h, yesterday"},
+		{"newfoundland", time.Date(2026, time.July, 15, 12, 0, 0, 0, time.UTC), london, stJohns, "−3:30, same day"},
+		{"same zone", time.Date(2026, time.July, 15, 12, 0, 0, 0, time.UTC), london, london, "same time, same day"},
+	}
+
+	for _, test := range tests {
+		got := NewRelativeOffset(test.at, test.home, test.target).String()
+		if got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkingHours represents a daily local working window; End before Start spans midnight
+type WorkingHours struct {
+	Start WallClock
+	End   WallClock
+}
+
+// DefaultWorkingHours is the 09:00–17:00 window used when none is given
+var DefaultWorkingHours = WorkingHours{
+	Start: WallClock{Hour: 9},
+	End:   WallClock{Hour: 17},
+}
+
+// ParseWorkingHours parses windows like "09:00-17:00", "9am-5pm" or "22:00–06:00"
+func ParseWorkingHours(input string) (WorkingHours, error) {
+	input = strings.ReplaceAll(strings.TrimSpace(input), "–", "-")
+	startStr, endStr, ok := strings.Cut(input, "-")
+	if !ok {
+		return WorkingHours{}, fmt.Errorf("invalid working hours: %s", input)
+	}
+
+	start, err := ParseWallClock(startStr)
+	if err != nil {
+		return WorkingHours{}, fmt.Errorf("invalid working hours: %s", input)
+	}
+	end, err := ParseWallClock(endStr)
+	if err != nil {
+		return WorkingHours{}, fmt.Errorf("invalid working hours: %s", input)
+	}
+	if start.DayOffset != 0 || end.DayOffset != 0 || *start == *end {
+		return WorkingHours{}, fmt.Errorf("invalid working hours: %s", input)
+	}
+
+	return WorkingHours{Start: *start, End: *end}, nil
+}
+
+// String returns the window in 24-hour notation
+func (w WorkingHours) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", w.Start.Hour, w.Start.Minute, w.End.Hour, w.End.Minute)
+}
+
+// TimeSlot represents a half-open interval of absolute time
+type TimeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the slot
+func (s TimeSlot) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// Participant is someone taking part in a meeting, working in a timezone
+type Participant struct {
+	Name        string
+	Timezone    *Timezone
+	Hours       WorkingHours
+	CountryCode string // whose public holidays apply; empty uses the timezone's principal country
+}
+
+// workingSlots returns the participant's working windows overlapping [from, to),
+// built per local calendar day so DST transitions are honoured on each date.
+// Weekends and public holidays in the participant's country are skipped
+func (p *Participant) workingSlots(from, to time.Time) ([]TimeSlot, error) {
+	loc, err := p.Timezone.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	country := p.CountryCode
+	if country == "" {
+		country = p.Timezone.CountryCode()
+	}
+
+	weekend := WeekendDays(country)
+
+	var slots []TimeSlot
+	first := from.In(loc).AddDate(0, 0, -1)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if containsWeekday(weekend, day.Weekday()) {
+			continue
+		}
+		if _, ok := HolidayOn(country, day); ok {
+			continue
+		}
+		start := p.Hours.Start.On(day, loc)
+		end := p.Hours.End.On(day, loc)
+		if !end.After(start) {
+			end = (&WallClock{Hour: p.Hours.End.Hour, Minute: p.Hours.End.Minute, DayOffset: 1}).On(day, loc)
+		}
+		if slot, ok := clip(TimeSlot{start, end}, from, to); ok {
+			slots = append(slots, slot)
+		}
+	}
+	return slots, nil
+}
+
+// FindOverlaps returns the slots within [from, to) where every participant is inside working hours
+func FindOverlaps(participants []*Participant, from, to time.Time) ([]TimeSlot, error) {
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("at least one participant is required")
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("end of range must be after its start")
+	}
+
+	var common []TimeSlot
+	for i, p := range participants {
+		slots, err := p.workingSlots(from, to)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			common = slots
+			continue
+		}
+		common = intersect(common, slots)
+	}
+	return common, nil
+}
+
+// intersect returns the intersection of two sorted, non-overlapping slot lists
+func intersect(a, b []TimeSlot) []TimeSlot {
+	var result []TimeSlot
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		start := latest(a[i].Start, b[j].Start)
+		end := earliest(a[i].End, b[j].End)
+		if end.After(start) {
+			result = append(result, TimeSlot{start, end})
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// clip restricts a slot to [from, to)
+func clip(slot TimeSlot, from, to time.Time) (TimeSlot, bool) {
+	slot.Start = latest(slot.Start, from)
+	slot.End = earliest(slot.End, to)
+	return slot, slot.End.After(slot.Start)
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWorkingHours(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"09:00-17:00", "09:00-17:00"},
+		{"9am-5pm", "09:00-17:00"},
+		{"22:00–06:00", "22:00-06:00"},
+		{" 08:30 - 16:30 ", "08:30-16:30"},
+	}
+
+	for _, test := range tests {
+		hours, err := ParseWorkingHours(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if hours.String() != test.expected {
+			t.Errorf("for %q, expected %s, got %s", test.input, test.expected, hours)
+		}
+	}
+
+	for _, input := range []string{"", "09:00", "9-17", "09:00-09:00", "tomorrow 9am-5pm"} {
+		if _, err := ParseWorkingHours(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestFindOverlaps_ShouldIntersectWorkingHoursAcrossZones(t *testing.T) {
+	// Given participants in London and New York with default hours
+	london := mustParticipant(t, "Europe/London", DefaultWorkingHours)
+	newYork := mustParticipant(t, "America/New_York", DefaultWorkingHours)
+	loc, _ := london.Timezone.Location()
+	from := time.Date(2026, time.June, 10, 0, 0, 0, 0, loc)
+
+	// When searching a single day
+	slots, err := FindOverlaps([]*Participant{london, newYork}, from, from.AddDate(0, 0, 1))
+
+	// Then the overlap should be 14:00–17:00 London time
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 1 {
+		t.Fatalf("expected 1 slot, got %d", len(slots))
+	}
+	assertSlot(t, slots[0], time.Date(2026, time.June, 10, 14, 0, 0, 0, loc), 3*time.Hour)
+}
+
+func TestFindOverlaps_ShouldHandleDifferentDSTDatesPerZone(t *testing.T) {
+	// Given Berlin and New York in the week the EU leaves DST before the US
+	berlin := mustParticipant(t, "Europe/Berlin", DefaultWorkingHours)
+	newYork := mustParticipant(t, "America/New_York", DefaultWorkingHours)
+	loc, _ := berlin.Timezone.Location()
+	from := time.Date(2026, time.October, 23, 0, 0, 0, 0, loc)
+
+	// When searching across the EU transition on 25 October
+	slots, err := FindOverlaps([]*Participant{berlin, newYork}, from, from.AddDate(0, 0, 4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 2 {
+		t.Fatalf("expected 2 slots, got %d", len(slots))
+	}
+
+	// Then the overlap grows from two to three hours once Berlin is on CET
+	assertSlot(t, slots[0], time.Date(2026, time.October, 23, 15, 0, 0, 0, loc), 2*time.Hour)
+	assertSlot(t, slots[1], time.Date(2026, time.October, 26, 14, 0, 0, 0, loc), 3*time.Hour)
+}
+
+func TestFindOverlaps_ShouldSupportWindowsSpanningMidnight(t *testing.T) {
+	// Given a night shift in UTC and a day shift in Tokyo
+	night := mustParticipant(t, "UTC", WorkingHours{Start: WallClock{Hour: 22}, End: WallClock{Hour: 6}})
+	tokyo := mustParticipant(t, "Asia/Tokyo", DefaultWorkingHours)
+	from := time.Date(2026, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	// When searching one UTC day
+	slots, err := FindOverlaps([]*Participant{night, tokyo}, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the overlap should be 00:00–06:00 UTC (09:00–15:00 Tokyo)
+	if len(slots) != 1 {
+		t.Fatalf("expected 1 slot, got %d", len(slots))
+	}
+	assertSlot(t, slots[0], from, 6*time.Hour)
+}
+
+func TestFindOverlaps_ShouldReturnNothingWhenHoursNeverMeet(t *testing.T) {
+	// Given participants whose working hours never meet
+	tokyo := mustParticipant(t, "Asia/Tokyo", DefaultWorkingHours)
+	la := mustParticipant(t, "America/Los_Angeles", DefaultWorkingHours)
+	from := time.Date(2026, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	// When searching
+	slots, err := FindOverlaps([]*Participant{tokyo, la}, from, from.AddDate(0, 0, 3))
+
+	// Then there should be no slots
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 0 {
+		t.Errorf("expected no slots, got %v", slots)
+	}
+}
+
+func TestFindOverlaps_ShouldSkipPublicHolidays(t *testing.T) {
+	// Given London and New York over Easter Monday, a holiday in Britain only
+	london := mustParticipant(t, "Europe/London", DefaultWorkingHours)
+	newYork := mustParticipant(t, "America/New_York", DefaultWorkingHours)
+	loc, _ := london.Timezone.Location()
+	from := time.Date(2026, time.April, 6, 0, 0, 0, 0, loc)
+
+	// When searching Easter Monday and the Tuesday after
+	slots, err := FindOverlaps([]*Participant{london, newYork}, from, from.AddDate(0, 0, 2))
+
+	// Then only Tuesday should have a slot
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 1 {
+		t.Fatalf("expected 1 slot, got %v", slots)
+	}
+	assertSlot(t, slots[0], time.Date(2026, time.April, 7, 14, 0, 0, 0, loc), 3*time.Hour)
+
+	// And a London participant following US holidays works on Easter Monday
+	london.CountryCode = "US"
+	slots, _ = FindOverlaps([]*Participant{london, newYork}, from, from.AddDate(0, 0, 2))
+	if len(slots) != 2 {
+		t.Errorf("expected 2 slots with US holidays, got %v", slots)
+	}
+}
+
+func TestFindOverlaps_ShouldSkipEachParticipantsWeekend(t *testing.T) {
+	// Given London, off on Saturday and Sunday, and Riyadh, off on Friday and Saturday
+	london := mustParticipant(t, "Europe/London", DefaultWorkingHours)
+	riyadh := mustParticipant(t, "Asia/Riyadh", DefaultWorkingHours)
+	loc, _ := london.Timezone.Location()
+	from := time.Date(2026, time.June, 11, 0, 0, 0, 0, loc)
+
+	// When searching from Thursday to the Monday after
+	slots, err := FindOverlaps([]*Participant{london, riyadh}, from, from.AddDate(0, 0, 5))
+
+	// Then only Thursday and Monday, when both work, should have a slot
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 2 {
+		t.Fatalf("expected 2 slots, got %v", slots)
+	}
+	assertSlot(t, slots[0], time.Date(2026, time.June, 11, 9, 0, 0, 0, loc), 6*time.Hour)
+	assertSlot(t, slots[1], time.Date(2026, time.June, 15, 9, 0, 0, 0, loc), 6*time.Hour)
+
+	// And a participant alone should get no weekend slots either
+	slots, _ = FindOverlaps([]*Participant{london}, from, from.AddDate(0, 0, 5))
+	for _, slot := range slots {
+		if day := slot.Start.In(loc).Weekday(); day == time.Saturday || day == time.Sunday {
+			t.Errorf("expected no weekend slots, got %v", slot.Start)
+		}
+	}
+}
+
+func mustParticipant(t *testing.T, zone string, hours WorkingHours) *Participant {
+	t.Helper()
+	tz, err := NewTimezone(zone)
+	if err != nil {
+		t.Fatalf("invalid zone %s: %v", zone, err)
+	}
+	return &Participant{Name: zone, Timezone: tz, Hours: hours}
+}
+
+func assertSlot(t *testing.T, slot TimeSlot, start time.Time, duration time.Duration) {
+	t.Helper()
+	if !slot.Start.Equal(start) || slot.Duration() != duration {
+		t.Errorf("expected %v for %v, got %v for %v", start, duration, slot.Start, slot.Duration())
+	}
+}
@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RelativeTime represents an expression such as "in 3 hours", "tomorrow 9am" or "next Monday noon"
+type RelativeTime struct {
+	Shift     time.Duration // exact offset from now, e.g. "in 3 hours" or "45 minutes ago"
+	Days      int           // calendar days from today, e.g. "tomorrow", "in 2 days" or "next week"
+	Weekday   *time.Weekday // day of the week, counted after Days
+	Direction int           // for Weekday: 0 today or later, 1 strictly later ("next"), -1 strictly earlier ("last")
+	Clock     *WallClock    // time of day; nil keeps the current time of day
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+var weekdayDirections = map[string]int{
+	"this": 0,
+	"next": 1,
+	"last": -1,
+}
+
+// durationUnits maps unit words to their length; days and weeks are calendar units
+var durationUnits = map[string]time.Duration{
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+// ParseRelativeTime parses a leading relative expression such as "tomorrow 9am" or
+// "in 3 hours" and returns the rest of the input, typically a place or timezone
+func ParseRelativeTime(input string) (*RelativeTime, string, error) {
+	fields := strings.Fields(input)
+	lowered := make([]string, len(fields))
+	for i, tok := range fields {
+		lowered[i] = strings.ToLower(tok)
+	}
+
+	rt, rest, ok := consumeRelativeTime(lowered)
+	if !ok {
+		return nil, "", fmt.Errorf("invalid relative time: %s", strings.TrimSpace(input))
+	}
+	return rt, strings.Join(fields[len(fields)-len(rest):], " "), nil
+}
+
+// Resolve returns the instant the expression denotes relative to now in loc. Wall clock
+// times skipped or repeated by a DST transition are reported as errors, see LocalDateTime.In
+func (rt *RelativeTime) Resolve(now time.Time, loc *time.Location) (time.Time, error) {
+	now = now.In(loc).Add(rt.Shift)
+	if rt.Days == 0 && rt.Weekday == nil && rt.Clock == nil {
+		return now, nil
+	}
+
+	days := rt.Days
+	if rt.Clock != nil {
+		days += rt.Clock.DayOffset
+	}
+	date := time.Date(now.Year(), now.Month(), now.Day()+days, 0, 0, 0, 0, time.UTC)
+	if rt.Weekday != nil {
+		delta := (int(*rt.Weekday) - int(date.Weekday()) + 7) % 7
+		switch {
+		case rt.Direction > 0 && delta == 0:
+			delta = 7
+		case rt.Direction < 0:
+			delta -= 7
+		}
+		date = date.AddDate(0, 0, delta)
+	}
+
+	dt := LocalDateTime{
+		Year: date.Year(), Month: date.Month(), Day: date.Day(),
+		Hour: now.Hour(), Minute: now.Minute(), Second: now.Second(),
+	}
+	if rt.Clock != nil {
+		dt.Hour, dt.Minute, dt.Second = rt.Clock.Hour, rt.Clock.Minute, 0
+	}
+	return dt.In(loc)
+}
+
+// Next returns the next instant after now that the expression denotes in loc: a bare
+// time of day such as "9am" that has already passed today means tomorrow, and a weekday
+// such as "Friday 17:00" that has passed this week means next week. Occurrences a DST
+// gap skips are passed over too, so "1:30" on the night clocks go from 1:00 to 2:00
+// means 1:30 the night after, and one a DST overlap repeats means its earlier instant,
+// or the later one once the earlier has passed. Other expressions resolve as in Resolve
+func (rt *RelativeTime) Next(now time.Time, loc *time.Location) (time.Time, error) {
+	step := 0
+	switch {
+	case rt.Shift != 0 || rt.Days != 0 || rt.Direction < 0:
+	case rt.Clock != nil && rt.Clock.DayOffset != 0:
+	case rt.Weekday != nil:
+		step = 7
+	case rt.Clock != nil:
+		step = 1
+	}
+	if step == 0 {
+		return rt.Resolve(now, loc)
+	}
+
+	later := *rt
+	var t time.Time
+	var err error
+	for i := 0; i < maxNextOccurrences; i++ {
+		later.Days = i * step
+		t, err = later.Resolve(now, loc)
+		var gap *NonexistentTimeError
+		if errors.As(err, &gap) {
+			continue
+		}
+		var overlap *AmbiguousTimeError
+		if errors.As(err, &overlap) {
+			t, err = overlap.Earlier, nil
+			if !t.After(now) {
+				t = overlap.Later
+			}
+		}
+		if err != nil || t.After(now) {
+			return t, err
+		}
+	}
+	return t, err
+}
+
+// maxNextOccurrences bounds how many occurrences Next tries: today's may have passed and
+// a DST gap may skip the one after, but no zone has gaps on consecutive days or weeks
+const maxNextOccurrences = 4
+
+// consumeRelativeTime reads "now", an exact shift, day words, a weekday and a time of
+// day from the front of lowercase tokens, in any order, and returns the remaining tokens
+func consumeRelativeTime(tokens []string) (*RelativeTime, []string, bool) {
+	rt := &RelativeTime{}
+	found, foundNow, foundAmount, foundDay, foundWeekday := false, false, false, false, false
+
+	for len(tokens) > 0 {
+		tok := tokens[0]
+
+		if tok == "now" && !foundNow {
+			foundNow, found = true, true
+			tokens = tokens[1:]
+			continue
+		}
+
+		// "in 3 hours", "in an hour"
+		if tok == "in" && !foundAmount {
+			if d, rest, ok := consumeAmount(tokens[1:]); ok {
+				rt.addAmount(d)
+				foundAmount, found = true, true
+				tokens = rest
+				continue
+			}
+		}
+
+		// "3 hours ago", "2 days ago"
+		if d, rest, ok := consumeAmount(tokens); ok && !foundAmount && len(rest) > 0 && rest[0] == "ago" {
+			rt.addAmount(-d)
+			foundAmount, found = true, true
+			tokens = rest[1:]
+			continue
+		}
+
+		if offset, ok := dayWords[tok]; ok && !foundDay {
+			rt.Days += offset
+			foundDay, found = true, true
+			tokens = tokens[1:]
+			continue
+		}
+
+		// "next week", "last week"
+		if direction, ok := weekdayDirections[tok]; ok && direction != 0 && len(tokens) > 1 && tokens[1] == "week" && !foundDay {
+			rt.Days += 7 * direction
+			foundDay, found = true, true
+			tokens = tokens[2:]
+			continue
+		}
+
+		// "monday", "next monday", "this friday"
+		if !foundWeekday {
+			direction, hasDirection := weekdayDirections[tok]
+			name := tok
+			if hasDirection && len(tokens) > 1 {
+				name = tokens[1]
+			}
+			if wd, ok := weekdays[name]; ok {
+				rt.Weekday, rt.Direction = &wd, direction
+				foundWeekday, found = true, true
+				tokens = tokens[1:]
+				if hasDirection {
+					tokens = tokens[1:]
+				}
+				continue
+			}
+		}
+
+		if rt.Clock == nil {
+			// "at 9am"
+			rest := tokens
+			if tok == "at" && len(tokens) > 1 {
+				rest = tokens[1:]
+			}
+			if clock, rest, ok := consumeTimeOfDay(rest); ok {
+				rt.Clock = clock
+				found, tokens = true, rest
+				continue
+			}
+		}
+		break
+	}
+
+	// An exact shift already fixes the time, so it cannot be combined with calendar parts
+	if !found || (rt.Shift != 0 && (rt.Clock != nil || rt.Weekday != nil || rt.Days != 0)) {
+		return nil, tokens, false
+	}
+	return rt, tokens, true
+}
+
+// addAmount applies a parsed amount: whole days and weeks move the calendar date,
+// anything shorter shifts the instant
+func (rt *RelativeTime) addAmount(d time.Duration) {
+	if d%(24*time.Hour) == 0 {
+		rt.Days += int(d / (24 * time.Hour))
+		return
+	}
+	rt.Shift += d
+}
+
+// consumeAmount reads "3 hours", "3h", "an hour" or "90 minutes" from the front of tokens
+func consumeAmount(tokens []string) (time.Duration, []string, bool) {
+	if len(tokens) == 0 {
+		return 0, tokens, false
+	}
+
+	// Compact form such as "3h" or "90m"
+	if i := strings.IndexFunc(tokens[0], func(r rune) bool { return r < '0' || r > '9' }); i > 0 {
+		if unit, ok := durationUnits[tokens[0][i:]]; ok {
+			n, err := strconv.Atoi(tokens[0][:i])
+			if err == nil {
+				return time.Duration(n) * unit, tokens[1:], true
+			}
+		}
+	}
+
+	if len(tokens) < 2 {
+		return 0, tokens, false
+	}
+	unit, ok := durationUnits[tokens[1]]
+	if !ok || len(tokens[1]) == 1 {
+		return 0, tokens, false
+	}
+	n, err := strconv.Atoi(tokens[0])
+	if tokens[0] == "a" || tokens[0] == "an" {
+		n, err = 1, nil
+	}
+	if err != nil || n < 0 {
+		return 0, tokens, false
+	}
+	return time.Duration(n) * unit, tokens[2:], true
+}
+
+// consumeTimeOfDay reads a time of day such as "9am", "9 am", "21:30" or "noon"
+func consumeTimeOfDay(tokens []string) (*WallClock, []string, bool) {
+	if len(tokens) > 1 && (tokens[1] == "am" || tokens[1] == "pm") {
+		if h, m, ok := parseTimeOfDay(tokens[0] + tokens[1]); ok {
+			return &WallClock{Hour: h, Minute: m}, tokens[2:], true
+		}
+	}
+	if len(tokens) > 0 {
+		if h, m, ok := parseTimeOfDay(tokens[0]); ok {
+			return &WallClock{Hour: h, Minute: m}, tokens[1:], true
+		}
+	}
+	return nil, tokens, false
+}
@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRelativeTime_ShouldResolveAgainstNow(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	// Wednesday 10 June 2026, 15:00 BST
+	now := time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"now", "2026-06-10 15:00"},
+		{"in 3 hours", "2026-06-10 18:00"},
+		{"in an hour", "2026-06-10 16:00"},
+		{"in 90m", "2026-06-10 16:30"},
+		{"45 minutes ago", "2026-06-10 14:15"},
+		{"in 2 days", "2026-06-12 15:00"},
+		{"tomorrow 9am", "2026-06-11 09:00"},
+		{"9 am tomorrow", "2026-06-11 09:00"},
+		{"yesterday midnight", "2026-06-09 00:00"},
+		{"noon", "2026-06-10 12:00"},
+		{"21:30", "2026-06-10 21:30"},
+		{"wednesday 10:00", "2026-06-10 10:00"},
+		{"next wednesday 10:00", "2026-06-17 10:00"},
+		{"next Monday noon", "2026-06-15 12:00"},
+		{"last friday at 5pm", "2026-06-05 17:00"},
+		{"friday", "2026-06-12 15:00"},
+		{"next week", "2026-06-17 15:00"},
+	}
+
+	for _, test := range tests {
+		rt, rest, err := ParseRelativeTime(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if rest != "" {
+			t.Errorf("for %q, unexpected leftover %q", test.input, rest)
+		}
+		got, err := rt.Resolve(now, london)
+		if err != nil {
+			t.Errorf("for %q, unexpected resolve error: %v", test.input, err)
+			continue
+		}
+		if got.Format("2006-01-02 15:04") != test.expected {
+			t.Errorf("for %q, expected %s, got %s", test.input, test.expected, got.Format("2006-01-02 15:04"))
+		}
+	}
+}
+
+func TestParseRelativeTime_ShouldReturnTrailingPlace(t *testing.T) {
+	rt, rest, err := ParseRelativeTime("tomorrow 9am New York")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rest != "New York" || rt.Days != 1 || rt.Clock.Hour != 9 {
+		t.Errorf("unexpected parse %+v, rest %q", rt, rest)
+	}
+}
+
+func TestParseRelativeTime_ShouldRejectInvalidExpressions(t *testing.T) {
+	for _, input := range []string{"", "London", "in 3 hours 9am", "in three hours", "25:00"} {
+		if rt, rest, err := ParseRelativeTime(input); err == nil && rest == "" {
+			t.Errorf("expected error for %q, got %+v", input, rt)
+		}
+	}
+}
+
+func TestRelativeTime_ShouldReportDSTGapsAndOverlaps(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+
+	// The day before clocks go forward, 01:30 tomorrow never happens
+	gap, _, _ := ParseRelativeTime("tomorrow 1:30")
+	_, err := gap.Resolve(time.Date(2026, time.March, 28, 12, 0, 0, 0, time.UTC), london)
+	var nonexistent *NonexistentTimeError
+	if !errors.As(err, &nonexistent) {
+		t.Errorf("expected NonexistentTimeError, got %v", err)
+	}
+
+	// The day before clocks go back, 01:30 tomorrow happens twice
+	overlap, _, _ := ParseRelativeTime("tomorrow 1:30")
+	_, err = overlap.Resolve(time.Date(2026, time.October, 24, 12, 0, 0, 0, time.UTC), london)
+	var ambiguous *AmbiguousTimeError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected AmbiguousTimeError, got %v", err)
+	}
+	if ambiguous.Later.Sub(ambiguous.Earlier) != time.Hour {
+		t.Errorf("expected candidates an hour apart, got %v and %v", ambiguous.Earlier, ambiguous.Later)
+	}
+	expected := "2026-10-25 01:30 is ambiguous in Europe/London (clocks go back): 01:30 BST (UTC+01:00) or 01:30 GMT (UTC+00:00)"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestRelativeTime_Next_ShouldRollPassedTimesForward(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	// Wednesday 10 June 2026, 15:00 BST
+	now := time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"9am", "2026-06-11 09:00"},
+		{"21:30", "2026-06-10 21:30"},
+		{"wednesday 10:00", "2026-06-17 10:00"},
+		{"wednesday 17:00", "2026-06-10 17:00"},
+		{"friday 17:00", "2026-06-12 17:00"},
+		{"tomorrow 9am", "2026-06-11 09:00"},
+		{"in 3 hours", "2026-06-10 18:00"},
+	}
+
+	for _, test := range tests {
+		rt, _, err := ParseRelativeTime(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		got, err := rt.Next(now, london)
+		if err != nil {
+			t.Errorf("for %q, unexpected resolve error: %v", test.input, err)
+			continue
+		}
+		if got.Format("2006-01-02 15:04") != test.expected {
+			t.Errorf("for %q, expected %s, got %s", test.input, test.expected, got.Format("2006-01-02 15:04"))
+		}
+	}
+}
+
+func TestRelativeTime_Next_ShouldSkipOccurrencesInDSTGaps(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+
+	tests := []struct {
+		input    string
+		now      time.Time
+		expected string
+	}{
+		// Saturday 28 March 2026, the day before clocks go from 01:00 to 02:00
+		{"1:30", time.Date(2026, time.March, 28, 12, 0, 0, 0, time.UTC), "2026-03-30 01:30 BST"},
+		// Sunday 29 March 2026 at 00:30, just before the gap
+		{"1:30", time.Date(2026, time.March, 29, 0, 30, 0, 0, time.UTC), "2026-03-30 01:30 BST"},
+		{"sunday 1:30", time.Date(2026, time.March, 27, 12, 0, 0, 0, time.UTC), "2026-04-05 01:30 BST"},
+		{"2:30", time.Date(2026, time.March, 28, 12, 0, 0, 0, time.UTC), "2026-03-29 02:30 BST"},
+	}
+
+	for _, test := range tests {
+		rt, _, err := ParseRelativeTime(test.input)
+		if err != nil {
+			t.Fatalf("for %q, unexpected error: %v", test.input, err)
+		}
+
+		// When asking for the next occurrence of a time the gap skips
+		got, err := rt.Next(test.now, london)
+
+		// Then the next day or week on which it exists should be chosen
+		if err != nil {
+			t.Errorf("for %q at %s, unexpected error: %v", test.input, test.now, err)
+			continue
+		}
+		if got.Format("2006-01-02 15:04 MST") != test.expected {
+			t.Errorf("for %q at %s, expected %s, got %s", test.input, test.now, test.expected, got.Format("2006-01-02 15:04 MST"))
+		}
+	}
+}
+
+func TestRelativeTime_Next_ShouldPickTheEarlierInstantInDSTOverlaps(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+
+	tests := []struct {
+		input    string
+		now      time.Time
+		expected string
+	}{
+		// Saturday 24 October 2026, the day before clocks go back from 02:00 to 01:00
+		{"1:30", time.Date(2026, time.October, 24, 12, 0, 0, 0, time.UTC), "2026-10-25 01:30 BST"},
+		{"sunday 1:30", time.Date(2026, time.October, 23, 12, 0, 0, 0, time.UTC), "2026-10-25 01:30 BST"},
+		// Sunday 25 October 2026 at 01:45 BST, after the first 1:30 but before the second
+		{"1:30", time.Date(2026, time.October, 25, 0, 45, 0, 0, time.UTC), "2026-10-25 01:30 GMT"},
+	}
+
+	for _, test := range tests {
+		rt, _, err := ParseRelativeTime(test.input)
+		if err != nil {
+			t.Fatalf("for %q, unexpected error: %v", test.input, err)
+		}
+
+		// When asking for the next occurrence of a time the overlap repeats
+		got, err := rt.Next(test.now, london)
+
+		// Then the first of its instants still ahead should be chosen
+		if err != nil {
+			t.Errorf("for %q at %s, unexpected error: %v", test.input, test.now, err)
+			continue
+		}
+		if got.Format("2006-01-02 15:04 MST") != test.expected {
+			t.Errorf("for %q at %s, expected %s, got %s", test.input, test.now, test.expected, got.Format("2006-01-02 15:04 MST"))
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// RulesDifference is the first instant at which two copies of a zone's rules, such as
+// the host's zoneinfo and an embedded tzdata release, disagree
+type RulesDifference struct {
+	At    time.Time
+	Left  ZonePeriod
+	Right ZonePeriod
+}
+
+// DiffZoneRules compares two loaded copies of the same zone over [from, to) and returns
+// where their offset, abbreviation or DST flag first differ, or nil if they agree
+func DiffZoneRules(left, right *time.Location, from, to time.Time) *RulesDifference {
+	for t := from; t.Before(to); {
+		l, r := zonePeriodAt(t.In(left)), zonePeriodAt(t.In(right))
+		if l.Offset != r.Offset || l.Abbreviation != r.Abbreviation || l.IsDST != r.IsDST {
+			return &RulesDifference{At: t.UTC(), Left: *l, Right: *r}
+		}
+
+		// Both copies hold steady until the earlier of their next boundaries
+		next := l.End
+		if next.IsZero() || (!r.End.IsZero() && r.End.Before(next)) {
+			next = r.End
+		}
+		if next.IsZero() {
+			return nil
+		}
+		t = next
+	}
+	return nil
+}
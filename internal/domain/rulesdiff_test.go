@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffZoneRules_ShouldFindFirstDisagreement(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// A copy of London's rules that never observes summer time parts ways at DST start
+	diff := DiffZoneRules(london, time.FixedZone("GMT", 0), from, to)
+	if diff == nil {
+		t.Fatal("expected a difference")
+	}
+	if !diff.At.Equal(time.Date(2026, time.March, 29, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the difference at DST start, got %v", diff.At)
+	}
+	if diff.Left.Abbreviation != "BST" || diff.Right.Abbreviation != "GMT" || diff.Left.Offset != 3600 {
+		t.Errorf("expected BST against GMT, got %+v and %+v", diff.Left, diff.Right)
+	}
+
+	// Identical rules agree
+	if diff := DiffZoneRules(london, london, from, to); diff != nil {
+		t.Errorf("expected no difference, got %+v", diff)
+	}
+}
+
+func TestTimezone_NextTransition_ShouldCrossLeapYearEnds(t *testing.T) {
+	// time.ZoneBounds reports an early end on the last day of leap years for rules
+	// taken from a zone's TZ string
+	tz, _ := NewTimezone("America/New_York")
+
+	tr, err := tz.NextTransition(time.Date(2040, time.December, 31, 12, 0, 0, 0, time.UTC))
+	if err != nil || tr == nil {
+		t.Fatalf("expected a transition, got %v, %v", tr, err)
+	}
+	if got := tr.At.UTC().Format("2006-01-02"); got != "2041-03-10" {
+		t.Errorf("expected DST start on 2041-03-10, got %s", got)
+	}
+}
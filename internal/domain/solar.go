@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// Sun altitudes, in degrees, that mark sunrise and sunset (allowing for refraction and
+// the solar disc) and the limits of civil twilight
+const (
+	sunriseAltitude       = -0.833
+	civilTwilightAltitude = -6.0
+)
+
+// SunTimes holds the sun's daily events at a place. Events that do not happen that
+// day, such as sunset during the midnight sun, are zero
+type SunTimes struct {
+	SolarNoon  time.Time
+	Sunrise    time.Time
+	Sunset     time.Time
+	CivilDawn  time.Time // start of morning civil twilight
+	CivilDusk  time.Time // end of evening civil twilight
+	PolarDay   bool      // the sun stays above the horizon all day
+	PolarNight bool      // the sun stays below the horizon all day
+}
+
+// SunTimesOn computes the sun's events for t's calendar day, read on its own location's
+// wall clock, at the given coordinates; results are in t's location and accurate to
+// about a minute outside the polar regions
+func SunTimesOn(lat, lng float64, t time.Time) SunTimes {
+	year, month, day := t.Date()
+	noon := time.Date(year, month, day, 12, 0, 0, 0, t.Location())
+
+	// Pick the mean solar noon nearest the local clock's noon, so zones far from
+	// their meridian still get the right day
+	cycle := math.Round(julianDate(noon) - 2451545.0 + lng/360)
+	meanNoon := cycle - lng/360
+
+	anomaly := normalizeDegrees(357.5291 + 0.98560028*meanNoon)
+	m := radians(anomaly)
+	center := 1.9148*math.Sin(m) + 0.0200*math.Sin(2*m) + 0.0003*math.Sin(3*m)
+	longitude := radians(normalizeDegrees(anomaly + center + 180 + 102.9372))
+	transit := 2451545.0 + meanNoon + 0.0053*math.Sin(m) - 0.0069*math.Sin(2*longitude)
+	declination := math.Asin(math.Sin(longitude) * math.Sin(radians(23.4397)))
+
+	loc := t.Location()
+	times := SunTimes{SolarNoon: fromJulianDate(transit).In(loc)}
+
+	switch hourAngle, ok := sunHourAngle(lat, declination, sunriseAltitude); {
+	case ok:
+		times.Sunrise = fromJulianDate(transit - hourAngle/360).In(loc)
+		times.Sunset = fromJulianDate(transit + hourAngle/360).In(loc)
+	case hourAngle > 0:
+		times.PolarNight = true
+	default:
+		times.PolarDay = true
+	}
+	if hourAngle, ok := sunHourAngle(lat, declination, civilTwilightAltitude); ok {
+		times.CivilDawn = fromJulianDate(transit - hourAngle/360).In(loc)
+		times.CivilDusk = fromJulianDate(transit + hourAngle/360).In(loc)
+	}
+	return times
+}
+
+// sunHourAngle returns the hour angle, in degrees, at which the sun crosses altitude;
+// when it never does it reports false with a positive value if the sun stays below
+func sunHourAngle(lat, declination, altitude float64) (float64, bool) {
+	phi := radians(lat)
+	cos := (math.Sin(radians(altitude)) - math.Sin(phi)*math.Sin(declination)) / (math.Cos(phi) * math.Cos(declination))
+	if cos > 1 {
+		return 1, false
+	}
+	if cos < -1 {
+		return -1, false
+	}
+	return math.Acos(cos) * 180 / math.Pi, true
+}
+
+// SunPhase describes how light it is outside
+type SunPhase int
+
+const (
+	SunDaylight SunPhase = iota
+	SunDawn              // morning civil twilight
+	SunDusk              // evening civil twilight
+	SunDark
+)
+
+// String returns a short description of the phase
+func (p SunPhase) String() string {
+	switch p {
+	case SunDaylight:
+		return "daylight"
+	case SunDawn:
+		return "dawn"
+	case SunDusk:
+		return "dusk"
+	case SunDark:
+		return "dark"
+	default:
+		return "unknown"
+	}
+}
+
+// SunStatus is the sun's phase at an instant and the next event that ends it: sunset
+// in daylight, nightfall at dusk (or sunrise on nights that never get dark), and
+// sunrise at dawn or in the dark. Next is zero during the midnight sun or polar night
+type SunStatus struct {
+	Phase     SunPhase
+	Next      time.Time
+	NextEvent string   // "sunrise", "sunset" or "nightfall"
+	Times     SunTimes // the day's events
+}
+
+// SunAt returns the sun's phase at t, an instant read on its own location's wall clock
+func (l *Location) SunAt(t time.Time) SunStatus {
+	times := l.SunTimes(t)
+	status := SunStatus{Phase: SunDark, Times: times}
+
+	switch {
+	case times.PolarDay:
+		status.Phase = SunDaylight
+	case times.PolarNight:
+		if !times.CivilDawn.IsZero() && !t.Before(times.CivilDawn) && t.Before(times.CivilDusk) {
+			status.Phase = SunDawn
+			if !t.Before(times.SolarNoon) {
+				status.Phase = SunDusk
+			}
+		}
+	case t.Before(times.Sunrise):
+		status.Next, status.NextEvent = times.Sunrise, "sunrise"
+		// Near midsummer the previous evening's twilight can run past midnight
+		if dusk := l.SunTimes(t.AddDate(0, 0, -1)).CivilDusk; t.Before(dusk) {
+			status.Phase, status.Next, status.NextEvent = SunDusk, dusk, "nightfall"
+		} else if times.CivilDawn.IsZero() || !t.Before(times.CivilDawn) {
+			status.Phase = SunDawn
+		}
+	case t.Before(times.Sunset):
+		status.Phase, status.Next, status.NextEvent = SunDaylight, times.Sunset, "sunset"
+	case times.CivilDusk.IsZero():
+		status.Phase, status.Next, status.NextEvent = SunDusk, l.SunTimes(t.AddDate(0, 0, 1)).Sunrise, "sunrise"
+	case t.Before(times.CivilDusk):
+		status.Phase, status.Next, status.NextEvent = SunDusk, times.CivilDusk, "nightfall"
+	default:
+		status.Next, status.NextEvent = l.SunTimes(t.AddDate(0, 0, 1)).Sunrise, "sunrise"
+	}
+	return status
+}
+
+// SunTimes computes the sun's events at the location on t's local calendar day
+func (l *Location) SunTimes(t time.Time) SunTimes {
+	return SunTimesOn(l.Latitude, l.Longitude, t)
+}
+
+func julianDate(t time.Time) float64 {
+	return float64(t.Unix())/86400 + 2440587.5
+}
+
+func fromJulianDate(jd float64) time.Time {
+	return time.Unix(0, int64((jd-2440587.5)*86400*float64(time.Second))).UTC().Truncate(time.Second)
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func normalizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSunTimesOn_ShouldMatchPublishedTimes(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	kiritimati, _ := time.LoadLocation("Pacific/Kiritimati")
+
+	tests := []struct {
+		name             string
+		lat, lng         float64
+		day              time.Time
+		sunrise, sunset  string
+		dawn, dusk, noon string
+	}{
+		{"London midsummer", 51.5074, -0.1278, time.Date(2026, time.June, 21, 0, 0, 0, 0, london), "04:43", "21:21", "03:55", "22:09", "13:02"},
+		{"London midwinter", 51.5074, -0.1278, time.Date(2026, time.December, 21, 0, 0, 0, 0, london), "08:04", "15:53", "07:24", "16:34", "11:58"},
+		{"Tokyo on Showa Day", 35.6895, 139.6917, time.Date(2026, time.April, 29, 0, 0, 0, 0, tokyo), "04:52", "18:25", "04:24", "18:52", "11:38"},
+		// UTC+14 is far from its meridian, but the day must still be the local one
+		{"Kiritimati", 1.87, -157.4, time.Date(2026, time.January, 1, 0, 0, 0, 0, kiritimati), "06:32", "18:33", "06:09", "18:55", "12:32"},
+	}
+
+	for _, test := range tests {
+		times := SunTimesOn(test.lat, test.lng, test.day)
+		for label, pair := range map[string][2]interface{}{
+			"sunrise":    {times.Sunrise, test.sunrise},
+			"sunset":     {times.Sunset, test.sunset},
+			"civil dawn": {times.CivilDawn, test.dawn},
+			"civil dusk": {times.CivilDusk, test.dusk},
+			"solar noon": {times.SolarNoon, test.noon},
+		} {
+			got, expected := pair[0].(time.Time), pair[1].(string)
+			if !withinMinutes(got, test.day, expected, 2) {
+				t.Errorf("%s: expected %s around %s, got %s", test.name, label, expected, got.Format("2006-01-02 15:04"))
+			}
+		}
+	}
+}
+
+func TestSunTimesOn_ShouldReportPolarDayAndNight(t *testing.T) {
+	longyearbyen, _ := time.LoadLocation("Arctic/Longyearbyen")
+
+	summer := SunTimesOn(78.22, 15.65, time.Date(2026, time.June, 21, 12, 0, 0, 0, longyearbyen))
+	if !summer.PolarDay || !summer.Sunrise.IsZero() || !summer.Sunset.IsZero() {
+		t.Errorf("expected the midnight sun, got %+v", summer)
+	}
+
+	winter := SunTimesOn(78.22, 15.65, time.Date(2026, time.December, 21, 12, 0, 0, 0, longyearbyen))
+	if !winter.PolarNight || !winter.Sunrise.IsZero() {
+		t.Errorf("expected the polar night, got %+v", winter)
+	}
+}
+
+func TestLocation_SunAt(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	place := &Location{Name: "London", Latitude: 51.5074, Longitude: -0.1278}
+
+	tests := []struct {
+		at       time.Time
+		phase    SunPhase
+		event    string
+		expected string
+	}{
+		{time.Date(2026, time.June, 21, 12, 0, 0, 0, london), SunDaylight, "sunset", "21:21"},
+		{time.Date(2026, time.June, 21, 4, 0, 0, 0, london), SunDawn, "sunrise", "04:43"},
+		{time.Date(2026, time.June, 21, 21, 45, 0, 0, london), SunDusk, "nightfall", "22:09"},
+		{time.Date(2026, time.June, 21, 23, 0, 0, 0, london), SunDark, "sunrise", "04:43"},
+		{time.Date(2026, time.June, 21, 2, 0, 0, 0, london), SunDark, "sunrise", "04:43"},
+	}
+
+	for _, test := range tests {
+		status := place.SunAt(test.at)
+		if status.Phase != test.phase || status.NextEvent != test.event || !withinMinutes(status.Next, status.Next, test.expected, 2) {
+			t.Errorf("at %s, expected %s until %s %s, got %s until %s %s", test.at.Format("15:04"),
+				test.phase, test.event, test.expected, status.Phase, status.NextEvent, status.Next.Format("15:04"))
+		}
+	}
+}
+
+func TestLocation_SunAt_ShouldCarryTwilightPastMidnight(t *testing.T) {
+	// Given Oslo around midsummer, where civil twilight ends after midnight
+	oslo, _ := time.LoadLocation("Europe/Oslo")
+	place := &Location{Name: "Oslo", Latitude: 59.91, Longitude: 10.75}
+
+	// When checking just after midnight
+	status := place.SunAt(time.Date(2026, time.June, 22, 0, 0, 0, 0, oslo))
+
+	// Then it should still be the previous evening's dusk
+	if status.Phase != SunDusk || status.NextEvent != "nightfall" {
+		t.Errorf("expected dusk until nightfall, got %s until %s %s", status.Phase, status.NextEvent, status.Next)
+	}
+}
+
+// withinMinutes reports whether got is within tolerance minutes of the wall clock "15:04" on day
+func withinMinutes(got, day time.Time, expected string, tolerance int) bool {
+	clock, err := time.Parse("15:04", expected)
+	if err != nil || got.IsZero() {
+		return false
+	}
+	want := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, got.Location())
+	diff := got.Sub(want)
+	return diff >= -time.Duration(tolerance)*time.Minute && diff <= time.Duration(tolerance)*time.Minute
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp is an instant parsed from a machine-readable representation
+type Timestamp struct {
+	Time   time.Time
+	Format string // the detected representation, e.g. "Unix milliseconds"
+}
+
+// timestampLayouts are tried in order; zone-less layouts are read as UTC
+var timestampLayouts = []struct {
+	name   string
+	layout string
+}{
+	{"RFC 3339", time.RFC3339Nano},
+	{"RFC 3339", "2006-01-02 15:04:05Z07:00"},
+	{"RFC 1123", time.RFC1123Z},
+	{"RFC 1123", time.RFC1123},
+	{"RFC 822", time.RFC822Z},
+	{"RFC 822", time.RFC822},
+	{"RFC 850", time.RFC850},
+	{"Unix date", time.UnixDate},
+	{"Unix date", time.RubyDate},
+	{"Common Log Format", "02/Jan/2006:15:04:05 -0700"},
+	{"ANSI C (assumed UTC)", time.ANSIC},
+	{"ISO 8601 (assumed UTC)", "2006-01-02T15:04:05"},
+	{"ISO 8601 (assumed UTC)", "2006-01-02 15:04:05"},
+}
+
+// rfc822Zones are the zone abbreviations RFC 822 gives fixed offsets
+var rfc822Zones = map[string]int{
+	"UT": 0, "UTC": 0, "GMT": 0, "Z": 0,
+	"EST": -5 * 3600, "EDT": -4 * 3600,
+	"CST": -6 * 3600, "CDT": -5 * 3600,
+	"MST": -7 * 3600, "MDT": -6 * 3600,
+	"PST": -8 * 3600, "PDT": -7 * 3600,
+}
+
+// unixUnits maps the digit count of an integer Unix timestamp to its unit
+var unixUnits = []struct {
+	maxDigits int
+	name      string
+	toTime    func(int64) time.Time
+}{
+	{10, "Unix seconds", func(n int64) time.Time { return time.Unix(n, 0) }},
+	{13, "Unix milliseconds", time.UnixMilli},
+	{16, "Unix microseconds", time.UnixMicro},
+	{19, "Unix nanoseconds", func(n int64) time.Time { return time.Unix(0, n) }},
+}
+
+// ParseTimestamp detects and parses Unix timestamps (s, ms, µs or ns by magnitude),
+// RFC 3339, RFC 1123, RFC 822 and common log timestamps
+func ParseTimestamp(input string) (*Timestamp, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return nil, fmt.Errorf("timestamp cannot be empty")
+	}
+
+	if ts, ok := parseUnixTimestamp(s); ok {
+		return ts, nil
+	}
+
+	// Common Log Format wraps the timestamp in brackets
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	for _, l := range timestampLayouts {
+		t, err := time.ParseInLocation(l.layout, s, time.UTC)
+		if err != nil {
+			continue
+		}
+		if strings.Contains(l.layout, "MST") {
+			if t, err = resolveZoneAbbreviation(t); err != nil {
+				return nil, err
+			}
+		}
+		return &Timestamp{Time: t, Format: l.name}, nil
+	}
+
+	return nil, fmt.Errorf("unrecognised timestamp: %s", s)
+}
+
+// parseUnixTimestamp parses integer timestamps, picking the unit from the digit count,
+// and decimal seconds such as "1718035200.25"
+func parseUnixTimestamp(s string) (*Timestamp, bool) {
+	digits := strings.TrimPrefix(s, "-")
+	intPart, fracPart, hasFrac := strings.Cut(digits, ".")
+	if !isDigits(intPart) || (hasFrac && !isDigits(fracPart)) {
+		return nil, false
+	}
+
+	if hasFrac {
+		if len(intPart) > 10 {
+			return nil, false
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, false
+		}
+		sec, frac := math.Modf(f)
+		return &Timestamp{
+			Time:   time.Unix(int64(sec), int64(math.Round(frac*1e9))).UTC(),
+			Format: "Unix seconds",
+		}, true
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	for _, u := range unixUnits {
+		if len(intPart) <= u.maxDigits {
+			return &Timestamp{Time: u.toTime(n).UTC(), Format: u.name}, true
+		}
+	}
+	return nil, false
+}
+
+// resolveZoneAbbreviation gives a parsed time the RFC 822 offset of its zone
+// abbreviation, since time.Parse reads unknown abbreviations as UTC+00:00
+func resolveZoneAbbreviation(t time.Time) (time.Time, error) {
+	name, _ := t.Zone()
+	offset, ok := rfc822Zones[name]
+	if !ok {
+		return time.Time{}, fmt.Errorf("ambiguous zone %s in timestamp, use a numeric offset", name)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.FixedZone(name, offset)), nil
+}
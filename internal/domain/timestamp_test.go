@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2024, time.June, 10, 16, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input    string
+		format   string
+		expected time.Time
+	}{
+		{"1718035200", "Unix seconds", want},
+		{"1718035200000", "Unix milliseconds", want},
+		{"1718035200000000", "Unix microseconds", want},
+		{"1718035200000000000", "Unix nanoseconds", want},
+		{"1718035200.25", "Unix seconds", want.Add(250 * time.Millisecond)},
+		{"2024-06-10T16:00:00Z", "RFC 3339", want},
+		{"2024-06-10T18:00:00+02:00", "RFC 3339", want},
+		{"2024-06-10 16:00:00Z", "RFC 3339", want},
+		{"Mon, 10 Jun 2024 12:00:00 -0400", "RFC 1123", want},
+		{"Mon, 10 Jun 2024 16:00:00 GMT", "RFC 1123", want},
+		{"10 Jun 24 09:00 PDT", "RFC 822", want},
+		{"[10/Jun/2024:18:00:00 +0200]", "Common Log Format", want},
+		{"2024-06-10T16:00:00", "ISO 8601 (assumed UTC)", want},
+	}
+
+	for _, test := range tests {
+		ts, err := ParseTimestamp(test.input)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if ts.Format != test.format {
+			t.Errorf("for %q, expected format %s, got %s", test.input, test.format, ts.Format)
+		}
+		if !ts.Time.Equal(test.expected) {
+			t.Errorf("for %q, expected %v, got %v", test.input, test.expected, ts.Time)
+		}
+	}
+}
+
+func TestParseTimestamp_Invalid(t *testing.T) {
+	for _, input := range []string{"", "   ", "yesterday", "12345678901234567890", "Mon, 10 Jun 2024 16:00:00 IST"} {
+		if _, err := ParseTimestamp(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
@@ -8,10 +8,22 @@ import (
 
 // Timezone represents a validated IANA timezone
 type Timezone struct {
-	Name string
+	Name  string
+	Alias string    // the deprecated or alternative tzdb name the zone was given as, if any
+	Place *Location // the geocoded place the zone was resolved for, when known
 }
 
-// NewTimezone creates a new Timezone after validation
+// loadLocation loads IANA zones for every Timezone; see SetLocationLoader
+var loadLocation = time.LoadLocation
+
+// SetLocationLoader replaces how zone rules are loaded, e.g. to read an embedded copy of
+// tzdata instead of the host's zoneinfo. It is meant to be called once at startup
+func SetLocationLoader(load func(name string) (*time.Location, error)) {
+	loadLocation = load
+}
+
+// NewTimezone creates a new Timezone after validation, accepting IANA names
+// and common abbreviations; links such as Asia/Calcutta are canonicalized, see Canonical
 func NewTimezone(name string) (*Timezone, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
@@ -19,17 +31,84 @@ func NewTimezone(name string) (*Timezone, error) {
 	}
 
 	// Validate that the timezone is loadable
-	_, err := time.LoadLocation(name)
+	_, err := loadLocation(name)
+	if err == nil {
+		return (&Timezone{Name: name}).Canonical(), nil
+	}
+
+	if tz, err := NewOffsetTimezone(name); err == nil {
+		return tz, nil
+	}
+
+	// Fall back to abbreviations such as "PST", or "est" for the tzdb EST zone
+	candidates := TimezonesForAbbreviation(name)
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	if len(candidates) > 1 {
+		return nil, &AmbiguousAbbreviationError{Abbreviation: strings.ToUpper(name), Candidates: candidates}
+	}
+	if upper := strings.ToUpper(name); upper != name && !strings.Contains(name, "/") {
+		if _, err := loadLocation(upper); err == nil {
+			return (&Timezone{Name: upper}).Canonical(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid timezone: %s", name)
+}
+
+// NewPlacedTimezone creates a Timezone from a name optionally followed by the
+// coordinates of the place it was resolved for and that place's name, e.g.
+// "America/Los_Angeles 45.523450,-122.676210 Portland, Oregon, United States" as
+// printed by geotz --coords
+func NewPlacedTimezone(input string) (*Timezone, error) {
+	name, place := SplitCoordinates(input)
+	tz, err := NewTimezone(name)
 	if err != nil {
-		return nil, fmt.Errorf("invalid timezone: %s", name)
+		return tz, err
 	}
+	tz.Place = place
+	return tz, nil
+}
+
+// SplitCoordinates separates the "latitude,longitude" coordinates following a zone name,
+// and the place name after them, if any, which becomes the location's DisplayName
+func SplitCoordinates(input string) (string, *Location) {
+	input = strings.TrimSpace(input)
+	fields := strings.Fields(input)
+	for i := 1; i < len(fields); i++ {
+		place, err := ParseCoordinates(fields[i])
+		if err != nil {
+			continue
+		}
+		place.DisplayName = strings.Join(fields[i+1:], " ")
+		return strings.Join(fields[:i], " "), place
+	}
+	return input, nil
+}
 
-	return &Timezone{Name: name}, nil
+// NewOffsetTimezone creates a fixed-offset Timezone from notations like "UTC+5:30",
+// "GMT-3" or "+0800"; it is named by its canonical form, e.g. "UTC+05:30"
+func NewOffsetTimezone(input string) (*Timezone, error) {
+	seconds, ok := ParseUTCOffset(input)
+	if !ok {
+		return nil, fmt.Errorf("invalid UTC offset: %s", strings.TrimSpace(input))
+	}
+	if seconds == 0 {
+		return &Timezone{Name: "UTC"}, nil
+	}
+	return &Timezone{Name: FormatUTCOffset(seconds)}, nil
 }
 
 // Location returns the Go time.Location for this timezone
 func (tz *Timezone) Location() (*time.Location, error) {
-	return time.LoadLocation(tz.Name)
+	loc, err := loadLocation(tz.Name)
+	if err != nil {
+		if seconds, ok := ParseUTCOffset(tz.Name); ok {
+			return time.FixedZone(offsetAbbreviation(seconds), seconds), nil
+		}
+	}
+	return loc, err
 }
 
 // String returns the timezone name
@@ -37,11 +116,269 @@ func (tz *Timezone) String() string {
 	return tz.Name
 }
 
-// CityFromTimezone extracts the city/region from an IANA timezone string
+// PlacedName returns the name followed by the place's coordinates and name when known,
+// the form NewPlacedTimezone reads back
+func (tz *Timezone) PlacedName() string {
+	if tz.Place == nil {
+		return tz.Name
+	}
+	if tz.Place.DisplayName != "" {
+		return tz.Name + " " + tz.Place.Coordinates() + " " + tz.Place.DisplayName
+	}
+	return tz.Name + " " + tz.Place.Coordinates()
+}
+
+// Coordinates returns where to compute local conditions such as daylight: the
+// geocoded place when known, otherwise the zone's principal location in tzdb
+func (tz *Timezone) Coordinates() (*Location, bool) {
+	if tz.Place != nil {
+		return tz.Place, true
+	}
+	if meta, ok := tz.Metadata(); ok {
+		return &Location{Name: meta.ExemplarCity, Latitude: meta.Latitude, Longitude: meta.Longitude}, true
+	}
+	return nil, false
+}
+
+// City returns the display city for the timezone, using the tzdb exemplar city
 func (tz *Timezone) City() string {
-	parts := strings.Split(tz.Name, "/")
-	if len(parts) > 1 {
-		return strings.ReplaceAll(parts[1], "_", " ")
+	return ExemplarCity(tz.Name)
+}
+
+// Metadata returns the tzdb zone table entry, if the zone has one
+func (tz *Timezone) Metadata() (*ZoneMetadata, bool) {
+	return LookupZoneMetadata(tz.Name)
+}
+
+// CountryCodes returns the ISO 3166 codes of countries using the timezone
+func (tz *Timezone) CountryCodes() []string {
+	if meta, ok := tz.Metadata(); ok {
+		return meta.CountryCodes
 	}
-	return tz.Name
-}
\ No newline at end of file
+	return nil
+}
+
+// CountryCode returns the ISO 3166 code of the timezone's principal country, or "" for
+// zones such as UTC or fixed offsets that belong to no country
+func (tz *Timezone) CountryCode() string {
+	if codes := tz.CountryCodes(); len(codes) > 0 {
+		return codes[0]
+	}
+	return ""
+}
+
+// maxZoneBoundarySteps bounds the search for an offset change across
+// boundaries where only the abbreviation or DST flag changes
+const maxZoneBoundarySteps = 64
+
+// Transition represents a change of UTC offset in a timezone; in History it may also be
+// a change of abbreviation or DST flag alone
+type Transition struct {
+	At              time.Time
+	OldOffset       int
+	NewOffset       int
+	OldAbbreviation string
+	NewAbbreviation string
+	OldIsDST        bool
+	NewIsDST        bool
+}
+
+// StartsDST reports whether the transition enters daylight saving time
+func (tr *Transition) StartsDST() bool {
+	return tr.NewIsDST && !tr.OldIsDST
+}
+
+// EndsDST reports whether the transition leaves daylight saving time
+func (tr *Transition) EndsDST() bool {
+	return tr.OldIsDST && !tr.NewIsDST
+}
+
+// NextTransition returns the first UTC-offset transition strictly after t,
+// or nil if the timezone has none
+func (tz *Timezone) NextTransition(t time.Time) (*Transition, error) {
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < maxZoneBoundarySteps; i++ {
+		_, end := zoneBoundsAt(t.In(loc))
+		if end.IsZero() {
+			return nil, nil
+		}
+		if tr := transitionAt(end, loc); tr != nil {
+			return tr, nil
+		}
+		t = end
+	}
+	return nil, nil
+}
+
+// PreviousTransition returns the last UTC-offset transition at or before t,
+// or nil if the timezone has none
+func (tz *Timezone) PreviousTransition(t time.Time) (*Transition, error) {
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < maxZoneBoundarySteps; i++ {
+		start, _ := t.In(loc).ZoneBounds()
+		if start.IsZero() {
+			return nil, nil
+		}
+		if tr := transitionAt(start, loc); tr != nil {
+			return tr, nil
+		}
+		t = start.Add(-time.Second)
+	}
+	return nil, nil
+}
+
+// Transitions returns every UTC-offset transition within [from, to)
+func (tz *Timezone) Transitions(from, to time.Time) ([]Transition, error) {
+	var transitions []Transition
+	t := from.Add(-time.Nanosecond)
+	for {
+		tr, err := tz.NextTransition(t)
+		if err != nil {
+			return nil, err
+		}
+		if tr == nil || !tr.At.Before(to) {
+			return transitions, nil
+		}
+		transitions = append(transitions, *tr)
+		t = tr.At
+	}
+}
+
+// transitionAt describes the zone boundary at instant at, or nil if the offset does not change there
+func transitionAt(at time.Time, loc *time.Location) *Transition {
+	if tr := zoneChangeAt(at, loc); tr != nil && tr.OldOffset != tr.NewOffset {
+		return tr
+	}
+	return nil
+}
+
+// History returns every change of UTC offset, abbreviation or DST flag within [from, to),
+// including changes such as a renamed abbreviation that keep the offset
+func (tz *Timezone) History(from, to time.Time) ([]Transition, error) {
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Transition
+	t := from.Add(-time.Nanosecond)
+	for {
+		_, end := zoneBoundsAt(t.In(loc))
+		if end.IsZero() || !end.Before(to) {
+			return changes, nil
+		}
+		if ch := zoneChangeAt(end, loc); ch != nil {
+			changes = append(changes, *ch)
+		}
+		t = end
+	}
+}
+
+// ObservesDST reports whether the zone is on daylight saving time at any point in the
+// calendar year containing t, as a zone's local year reckons it
+func (tz *Timezone) ObservesDST(t time.Time) (bool, error) {
+	loc, err := tz.Location()
+	if err != nil {
+		return false, err
+	}
+
+	local := t.In(loc)
+	if zonePeriodAt(local).IsDST {
+		return true, nil
+	}
+	from := time.Date(local.Year(), time.January, 1, 0, 0, 0, 0, loc)
+	changes, err := tz.History(from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return false, err
+	}
+	for i := range changes {
+		if changes[i].StartsDST() || changes[i].EndsDST() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ZonePeriod is a span during which a zone keeps the same offset, abbreviation and DST flag
+type ZonePeriod struct {
+	Offset       int
+	Abbreviation string
+	IsDST        bool
+	Start        time.Time // zero when the zone has always been this way
+	End          time.Time // zero when no change is scheduled
+}
+
+// PeriodAt returns the offset, abbreviation and DST flag in effect at t, with the
+// span over which they applied
+func (tz *Timezone) PeriodAt(t time.Time) (*ZonePeriod, error) {
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	local := t.In(loc)
+	period := zonePeriodAt(local)
+
+	start, end := period.Start, period.End
+	for i := 0; i < maxZoneBoundarySteps && !start.IsZero() && zoneChangeAt(start, loc) == nil; i++ {
+		start, _ = start.Add(-time.Second).In(loc).ZoneBounds()
+	}
+	for i := 0; i < maxZoneBoundarySteps && !end.IsZero() && zoneChangeAt(end, loc) == nil; i++ {
+		_, end = zoneBoundsAt(end.In(loc))
+	}
+	if !start.IsZero() {
+		period.Start = start.In(loc)
+	}
+	if !end.IsZero() {
+		period.End = end.In(loc)
+	}
+	return period, nil
+}
+
+// zonePeriodAt returns the offset, abbreviation and DST flag at t, read in its own
+// location, bounded by the nearest zone boundaries even if nothing visible changes there
+func zonePeriodAt(t time.Time) *ZonePeriod {
+	abbr, offset := t.Zone()
+	start, end := zoneBoundsAt(t)
+	return &ZonePeriod{Offset: offset, Abbreviation: abbr, IsDST: t.IsDST(), Start: start, End: end}
+}
+
+// zoneBoundsAt returns t.ZoneBounds, read in t's location, stepping past the end that
+// time.ZoneBounds reports too early, at or before t, on the last day of leap years in
+// zones whose later rules come from a POSIX TZ string
+func zoneBoundsAt(t time.Time) (start, end time.Time) {
+	start, end = t.ZoneBounds()
+	if !end.IsZero() && !end.After(t) {
+		_, end = end.Add(24 * time.Hour).In(t.Location()).ZoneBounds()
+	}
+	return start, end
+}
+
+// zoneChangeAt describes the zone boundary at instant at, or nil if nothing visible changes there
+func zoneChangeAt(at time.Time, loc *time.Location) *Transition {
+	before := at.Add(-time.Second).In(loc)
+	after := at.In(loc)
+	oldAbbr, oldOffset := before.Zone()
+	newAbbr, newOffset := after.Zone()
+	if oldOffset == newOffset && oldAbbr == newAbbr && before.IsDST() == after.IsDST() {
+		return nil
+	}
+
+	return &Transition{
+		At:              after,
+		OldOffset:       oldOffset,
+		NewOffset:       newOffset,
+		OldAbbreviation: oldAbbr,
+		NewAbbreviation: newAbbr,
+		OldIsDST:        before.IsDST(),
+		NewIsDST:        after.IsDST(),
+	}
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNewTimezone_Valid(t *testing.T) {
@@ -52,6 +53,13 @@ func TestTimezone_City(t *testing.T) {
 		{"Europe/London", "London"},
 		{"Asia/Ho_Chi_Minh", "Ho Chi Minh"},
 		{"UTC", "UTC"},
+		{"Etc/UTC", "UTC"},
+		{"America/Argentina/Buenos_Aires", "Buenos Aires"},
+		{"America/Indiana/Knox", "Knox"},
+		{"Etc/GMT+5", "UTC-05:00"},
+		{"Etc/GMT-14", "UTC+14:00"},
+		{"Etc/GMT0", "GMT"},
+		{"US/Pacific", "Los Angeles"},
 	}
 
 	for _, test := range tests {
@@ -61,4 +69,275 @@ func TestTimezone_City(t *testing.T) {
 			t.Errorf("for timezone %s, expected city %s, got %s", test.timezone, test.expected, city)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestTimezone_NextTransition_ShouldFindUpcomingDSTChange(t *testing.T) {
+	// Given Europe/Berlin in early October
+	tz, _ := NewTimezone("Europe/Berlin")
+	at := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	// When finding the next transition
+	tr, err := tz.NextTransition(at)
+	if err != nil || tr == nil {
+		t.Fatalf("expected a transition, got %v, %v", tr, err)
+	}
+
+	// Then it should be the end of DST on 25 October at 01:00 UTC
+	if !tr.At.Equal(time.Date(2026, time.October, 25, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected transition instant %v", tr.At)
+	}
+	if tr.OldAbbreviation != "CEST" || tr.NewAbbreviation != "CET" {
+		t.Errorf("expected CEST→CET, got %s→%s", tr.OldAbbreviation, tr.NewAbbreviation)
+	}
+	if tr.OldOffset != 7200 || tr.NewOffset != 3600 {
+		t.Errorf("unexpected offsets %d→%d", tr.OldOffset, tr.NewOffset)
+	}
+	if !tr.EndsDST() || tr.StartsDST() {
+		t.Error("expected transition to end DST")
+	}
+}
+
+func TestTimezone_PreviousTransition_ShouldFindLastDSTChange(t *testing.T) {
+	// Given America/New_York in early October
+	tz, _ := NewTimezone("America/New_York")
+	at := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	// When finding the previous transition
+	tr, err := tz.PreviousTransition(at)
+	if err != nil || tr == nil {
+		t.Fatalf("expected a transition, got %v, %v", tr, err)
+	}
+
+	// Then it should be the start of DST on 8 March
+	if !tr.At.Equal(time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected transition instant %v", tr.At)
+	}
+	if !tr.StartsDST() {
+		t.Error("expected transition to start DST")
+	}
+}
+
+func TestTimezone_Transitions_ShouldListChangesInRange(t *testing.T) {
+	// Given a zone with DST and one without
+	london, _ := NewTimezone("Europe/London")
+	tokyo, _ := NewTimezone("Asia/Tokyo")
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	// When listing a year's transitions
+	londonTransitions, _ := london.Transitions(from, to)
+	tokyoTransitions, _ := tokyo.Transitions(from, to)
+
+	// Then London should switch twice and Tokyo never
+	if len(londonTransitions) != 2 {
+		t.Errorf("expected 2 transitions for London, got %d", len(londonTransitions))
+	}
+	if len(tokyoTransitions) != 0 {
+		t.Errorf("expected no transitions for Tokyo, got %d", len(tokyoTransitions))
+	}
+
+	// And a zone without transitions has no next one
+	utc, _ := NewTimezone("UTC")
+	if tr, err := utc.NextTransition(from); tr != nil || err != nil {
+		t.Errorf("expected no transition for UTC, got %v, %v", tr, err)
+	}
+}
+
+func TestNewTimezone_ShouldBuildFixedOffsetZones(t *testing.T) {
+	// Given a UTC offset with minutes
+	tz, err := NewTimezone("UTC+5:30")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then it should be named canonically and display the offset as its city
+	if tz.Name != "UTC+05:30" || tz.City() != "UTC+05:30" {
+		t.Errorf("unexpected name %s and city %s", tz.Name, tz.City())
+	}
+
+	// And its location should be fixed with a tzdb-style abbreviation
+	loc, err := tz.Location()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	abbr, offset := time.Date(2026, time.July, 1, 0, 0, 0, 0, loc).Zone()
+	if abbr != "+0530" || offset != 5*3600+30*60 {
+		t.Errorf("expected +0530 at 19800s, got %s at %d", abbr, offset)
+	}
+
+	// And it should never transition
+	if tr, err := tz.NextTransition(time.Now()); err != nil || tr != nil {
+		t.Errorf("expected no transitions, got %v, %v", tr, err)
+	}
+}
+
+func TestNewTimezone_ShouldTreatZeroOffsetAsUTC(t *testing.T) {
+	tz, err := NewTimezone("GMT+00:00")
+	if err != nil || tz.Name != "UTC" {
+		t.Errorf("expected UTC, got %v, %v", tz, err)
+	}
+}
+
+func TestNewTimezone_ShouldKeepTzdbEtcZones(t *testing.T) {
+	// Given a tzdb name that looks like an offset
+	tz, err := NewTimezone("Etc/GMT+5")
+
+	// Then the tzdb zone should be kept, with its inverted POSIX sign
+	if err != nil || tz.Name != "Etc/GMT+5" {
+		t.Fatalf("expected Etc/GMT+5, got %v, %v", tz, err)
+	}
+	loc, _ := tz.Location()
+	if _, offset := time.Now().In(loc).Zone(); offset != -5*3600 {
+		t.Errorf("expected UTC-05:00, got %d", offset)
+	}
+}
+
+func TestNewPlacedTimezone_ShouldKeepTrailingCoordinates(t *testing.T) {
+	// Given a zone followed by coordinates, as geotz --coords prints it
+	tz, err := NewPlacedTimezone("Europe/Paris 48.858400,2.294500")
+
+	// Then the place should be kept and round-trip through PlacedName
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tz.Name != "Europe/Paris" || tz.Place == nil || tz.Place.Latitude != 48.8584 {
+		t.Fatalf("unexpected timezone %+v", tz)
+	}
+	if tz.PlacedName() != "Europe/Paris 48.858400,2.294500" {
+		t.Errorf("unexpected placed name %q", tz.PlacedName())
+	}
+
+	// And so should the place's name after the coordinates
+	tz, err = NewPlacedTimezone("America/Los_Angeles 45.523450,-122.676210 Portland, Oregon, United States")
+	if err != nil || tz.Name != "America/Los_Angeles" || tz.Place == nil || tz.Place.DisplayName != "Portland, Oregon, United States" {
+		t.Fatalf("unexpected timezone %+v, %v", tz, err)
+	}
+	if tz.PlacedName() != "America/Los_Angeles 45.523450,-122.676210 Portland, Oregon, United States" {
+		t.Errorf("unexpected placed name %q", tz.PlacedName())
+	}
+
+	// And names without coordinates should be unaffected
+	if tz, err := NewPlacedTimezone("America/New_York"); err != nil || tz.Place != nil {
+		t.Errorf("expected a plain zone, got %+v, %v", tz, err)
+	}
+}
+
+func TestTimezone_Coordinates_ShouldFallBackToPrincipalLocation(t *testing.T) {
+	tokyo, _ := NewTimezone("Asia/Tokyo")
+	place, ok := tokyo.Coordinates()
+	if !ok || place.Latitude < 35 || place.Latitude > 36 || place.Longitude < 139 || place.Longitude > 140 {
+		t.Errorf("expected Tokyo's tzdb coordinates, got %+v", place)
+	}
+
+	utc, _ := NewTimezone("UTC+05:30")
+	if _, ok := utc.Coordinates(); ok {
+		t.Error("expected no coordinates for a fixed offset")
+	}
+}
+
+func TestTimezone_History_ShouldKeepChangesThatKeepTheOffsetFlag(t *testing.T) {
+	moscow, _ := NewTimezone("Europe/Moscow")
+	from := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	history, err := moscow.History(from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 2010's DST pair, permanent summer time in March 2011 and the return to UTC+3 in 2014
+	if len(history) != 4 {
+		t.Fatalf("expected 4 changes, got %d: %+v", len(history), history)
+	}
+	if got := history[2]; got.At.UTC().Format("2006-01-02") != "2011-03-26" || got.NewOffset != 4*3600 {
+		t.Errorf("expected the switch to UTC+04:00 on 2011-03-26 UTC, got %+v", got)
+	}
+	if got := history[3]; got.At.UTC().Format("2006-01-02") != "2014-10-25" || got.NewOffset != 3*3600 {
+		t.Errorf("expected the return to UTC+03:00 on 2014-10-25 UTC, got %+v", got)
+	}
+}
+
+func TestTimezone_PeriodAt_ShouldReportTheOffsetInEffectThen(t *testing.T) {
+	caracas, _ := NewTimezone("America/Caracas")
+
+	period, err := caracas.PeriodAt(time.Date(2010, time.June, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Venezuela ran on UTC-04:30 from December 2007 to May 2016
+	if period.Offset != -(4*3600+30*60) || period.IsDST {
+		t.Errorf("expected standard UTC-04:30, got %+v", period)
+	}
+	if period.Start.UTC().Format("2006-01-02") != "2007-12-09" || period.End.UTC().Format("2006-01-02") != "2016-05-01" {
+		t.Errorf("expected 2007-12-09 to 2016-05-01, got %v to %v", period.Start.UTC(), period.End.UTC())
+	}
+
+	// A fixed zone has neither bound
+	utc, _ := NewTimezone("UTC")
+	if period, err := utc.PeriodAt(time.Now()); err != nil || !period.Start.IsZero() || !period.End.IsZero() {
+		t.Errorf("expected an unbounded UTC period, got %+v, %v", period, err)
+	}
+}
+
+func TestNewTimezone_ShouldCanonicalizeLinks(t *testing.T) {
+	tests := map[string]string{
+		"Asia/Calcutta":        "Asia/Kolkata",
+		"US/Pacific":           "America/Los_Angeles",
+		"Europe/Kiev":          "Europe/Kyiv",
+		"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+	}
+
+	for alias, expected := range tests {
+		tz, err := NewTimezone(alias)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", alias, err)
+		}
+		if tz.Name != expected || tz.Alias != alias {
+			t.Errorf("for %s, expected %s with alias %s, got %s with alias %q", alias, expected, alias, tz.Name, tz.Alias)
+		}
+	}
+
+	// Current names and the bare UTC zone are kept as they are
+	for _, name := range []string{"Asia/Kolkata", "UTC", "Asia/Kuwait"} {
+		if tz, _ := NewTimezone(name); tz.Name != name || tz.Alias != "" {
+			t.Errorf("expected %s unchanged, got %+v", name, tz)
+		}
+	}
+}
+
+func TestCanonicalZoneNameFold_ShouldIgnoreCase(t *testing.T) {
+	if got, ok := CanonicalZoneNameFold("asia/saigon"); !ok || got != "Asia/Ho_Chi_Minh" {
+		t.Errorf("expected Asia/Ho_Chi_Minh, got %q, %v", got, ok)
+	}
+	if _, ok := CanonicalZoneNameFold("asia/ho_chi_minh"); ok {
+		t.Error("expected a current name not to be a link")
+	}
+}
+
+func TestTimezone_ObservesDST_ShouldDependOnTheYear(t *testing.T) {
+	tests := []struct {
+		zone string
+		at   time.Time
+		want bool
+	}{
+		// London changes clocks twice a year, even while on standard time in January
+		{"Europe/London", time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC), true},
+		// Kolkata has not observed DST for decades
+		{"Asia/Kolkata", time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC), false},
+		// Moscow observed DST until 2011
+		{"Europe/Moscow", time.Date(2010, time.February, 1, 12, 0, 0, 0, time.UTC), true},
+		{"Europe/Moscow", time.Date(2026, time.February, 1, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		tz, _ := NewTimezone(tt.zone)
+		got, err := tz.ObservesDST(tt.at)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("%s.ObservesDST(%d) = %v, want %v", tt.zone, tt.at.Year(), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed tzdata/zone1970.tab
+var zone1970Tab string
+
+//go:embed tzdata/zone.tab
+var zoneTab string
+
+//go:embed tzdata/iso3166.tab
+var iso3166Tab string
+
+//go:embed tzdata/zones
+var zonesList string
+
+// ZoneMetadata describes a timezone as listed in tzdb's zone1970.tab and zone.tab
+type ZoneMetadata struct {
+	Name         string
+	ExemplarCity string
+	CountryCodes []string // most populous country first
+	Comments     string
+	Latitude     float64 // principal location
+	Longitude    float64
+}
+
+var (
+	zoneMetadataOnce sync.Once
+	zoneMetadata     map[string]*ZoneMetadata
+	countryNames     map[string]string
+)
+
+// LookupZoneMetadata returns the tzdb metadata for a zone name
+func LookupZoneMetadata(name string) (*ZoneMetadata, bool) {
+	zoneMetadataOnce.Do(loadZoneMetadata)
+	meta, ok := zoneMetadata[name]
+	return meta, ok
+}
+
+// ZoneNames returns the name of every zone in tzdb, sorted, leaving out links. Zones
+// no country's table lists, such as Etc/GMT+5, are included
+func ZoneNames() []string {
+	var names []string
+	scanner := bufio.NewScanner(strings.NewReader(zonesList))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+	return names
+}
+
+// CountryName returns the ISO 3166 name for a two-letter country code, or the code itself
+func CountryName(code string) string {
+	zoneMetadataOnce.Do(loadZoneMetadata)
+	if name, ok := countryNames[strings.ToUpper(code)]; ok {
+		return name
+	}
+	return code
+}
+
+// ExemplarCity derives the display city for a zone name, e.g. "Buenos Aires"
+// for America/Argentina/Buenos_Aires or "UTC-05:00" for Etc/GMT+5
+func ExemplarCity(name string) string {
+	if meta, ok := LookupZoneMetadata(name); ok {
+		return meta.ExemplarCity
+	}
+
+	base := strings.TrimPrefix(name, "Etc/")
+	if base != name || !strings.Contains(name, "/") {
+		// POSIX-style Etc/GMT+5 zones are five hours behind UTC
+		for _, prefix := range []string{"GMT", "UTC"} {
+			rest := strings.TrimPrefix(base, prefix)
+			if rest == base || rest == "" {
+				continue
+			}
+			if hours, err := strconv.Atoi(rest); err == nil && hours != 0 {
+				return FormatUTCOffset(-hours * 3600)
+			}
+			if rest == "0" || rest == "+0" || rest == "-0" {
+				return prefix
+			}
+		}
+		return base
+	}
+
+	parts := strings.Split(name, "/")
+	return strings.ReplaceAll(parts[len(parts)-1], "_", " ")
+}
+
+// loadZoneMetadata parses the embedded tzdb tables
+func loadZoneMetadata() {
+	zoneMetadata = make(map[string]*ZoneMetadata)
+	countryNames = make(map[string]string)
+
+	eachTabRow(iso3166Tab, func(fields []string) {
+		if len(fields) >= 2 {
+			countryNames[fields[0]] = fields[1]
+		}
+	})
+
+	// zone1970.tab lists every country sharing a zone; zone.tab adds
+	// per-country zones and links such as Europe/Vatican
+	for _, table := range []string{zone1970Tab, zoneTab} {
+		eachTabRow(table, func(fields []string) {
+			if len(fields) < 3 {
+				return
+			}
+			codes := strings.Split(fields[0], ",")
+			name := fields[2]
+			comments := ""
+			if len(fields) > 3 {
+				comments = fields[3]
+			}
+
+			if meta, ok := zoneMetadata[name]; ok {
+				for _, code := range codes {
+					if !containsString(meta.CountryCodes, code) {
+						meta.CountryCodes = append(meta.CountryCodes, code)
+					}
+				}
+				if meta.Comments == "" {
+					meta.Comments = comments
+				}
+				return
+			}
+
+			lat, lng, err := parseISO6709(fields[1])
+			if err != nil {
+				return
+			}
+			parts := strings.Split(name, "/")
+			zoneMetadata[name] = &ZoneMetadata{
+				Name:         name,
+				ExemplarCity: strings.ReplaceAll(parts[len(parts)-1], "_", " "),
+				CountryCodes: codes,
+				Comments:     comments,
+				Latitude:     lat,
+				Longitude:    lng,
+			}
+		})
+	}
+}
+
+// eachTabRow calls fn with the tab-separated fields of every non-comment line
+func eachTabRow(table string, fn func(fields []string)) {
+	scanner := bufio.NewScanner(strings.NewReader(table))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fn(strings.Split(line, "\t"))
+	}
+}
+
+// parseISO6709 parses ±DDMM±DDDMM or ±DDMMSS±DDDMMSS coordinates
+func parseISO6709(s string) (lat, lng float64, err error) {
+	split := strings.IndexAny(s[1:], "+-") + 1
+	if split <= 0 {
+		return 0, 0, fmt.Errorf("invalid coordinates: %s", s)
+	}
+	if lat, err = parseISO6709Component(s[:split], 2); err != nil {
+		return 0, 0, err
+	}
+	if lng, err = parseISO6709Component(s[split:], 3); err != nil {
+		return 0, 0, err
+	}
+	return lat, lng, nil
+}
+
+func parseISO6709Component(s string, degreeDigits int) (float64, error) {
+	sign := 1.0
+	if s[0] == '-' {
+		sign = -1
+	}
+	digits := s[1:]
+	if len(digits) != degreeDigits+2 && len(digits) != degreeDigits+4 {
+		return 0, fmt.Errorf("invalid coordinate: %s", s)
+	}
+
+	value := 0.0
+	divisor := 1.0
+	for i := 0; i < len(digits); {
+		width := 2
+		if i == 0 {
+			width = degreeDigits
+		}
+		n, err := strconv.Atoi(digits[i : i+width])
+		if err != nil {
+			return 0, fmt.Errorf("invalid coordinate: %s", s)
+		}
+		value += float64(n) / divisor
+		divisor *= 60
+		i += width
+	}
+	return sign * value, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLookupZoneMetadata_ShouldReturnZone1970Entry(t *testing.T) {
+	// Given a zone shared by several countries
+	meta, ok := LookupZoneMetadata("Europe/Zurich")
+
+	// Then it should list every country, most populous first
+	if !ok {
+		t.Fatal("expected metadata for Europe/Zurich")
+	}
+	if meta.ExemplarCity != "Zurich" {
+		t.Errorf("expected exemplar city 'Zurich', got %q", meta.ExemplarCity)
+	}
+	if len(meta.CountryCodes) < 3 || meta.CountryCodes[0] != "CH" {
+		t.Errorf("expected CH first among several countries, got %v", meta.CountryCodes)
+	}
+
+	// And carry its principal location
+	if math.Abs(meta.Latitude-47.3833) > 0.01 || math.Abs(meta.Longitude-8.5333) > 0.01 {
+		t.Errorf("unexpected coordinates %f,%f", meta.Latitude, meta.Longitude)
+	}
+}
+
+func TestLookupZoneMetadata_ShouldIncludeZoneTabOnlyEntries(t *testing.T) {
+	// Given a link zone listed only in zone.tab
+	meta, ok := LookupZoneMetadata("Europe/Vatican")
+
+	// Then it should still be found with its country
+	if !ok {
+		t.Fatal("expected metadata for Europe/Vatican")
+	}
+	if len(meta.CountryCodes) != 1 || meta.CountryCodes[0] != "VA" {
+		t.Errorf("expected country VA, got %v", meta.CountryCodes)
+	}
+}
+
+func TestLookupZoneMetadata_ShouldKeepComments(t *testing.T) {
+	// Given a zone with a descriptive comment
+	meta, ok := LookupZoneMetadata("America/Argentina/Buenos_Aires")
+
+	// Then the comment should be kept alongside the exemplar city
+	if !ok {
+		t.Fatal("expected metadata for America/Argentina/Buenos_Aires")
+	}
+	if meta.ExemplarCity != "Buenos Aires" || meta.Comments == "" {
+		t.Errorf("unexpected metadata %+v", meta)
+	}
+}
+
+func TestLookupZoneMetadata_ShouldMissEtcZones(t *testing.T) {
+	if _, ok := LookupZoneMetadata("Etc/GMT+5"); ok {
+		t.Error("expected no metadata for Etc/GMT+5")
+	}
+}
+
+func TestCountryName(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"JP", "Japan"},
+		{"gb", "Britain (UK)"},
+		{"XX", "XX"},
+	}
+
+	for _, test := range tests {
+		if got := CountryName(test.code); got != test.expected {
+			t.Errorf("CountryName(%q) = %q, want %q", test.code, got, test.expected)
+		}
+	}
+}
+
+func TestParseISO6709(t *testing.T) {
+	tests := []struct {
+		input    string
+		lat, lng float64
+	}{
+		{"+4030-07400", 40.5, -74},
+		{"-3436-05827", -34.6, -58.45},
+		{"+404251-0740023", 40.7142, -74.0064},
+	}
+
+	for _, test := range tests {
+		lat, lng, err := parseISO6709(test.input)
+		if err != nil {
+			t.Errorf("parseISO6709(%q) failed: %v", test.input, err)
+			continue
+		}
+		if math.Abs(lat-test.lat) > 0.001 || math.Abs(lng-test.lng) > 0.001 {
+			t.Errorf("parseISO6709(%q) = %f,%f, want %f,%f", test.input, lat, lng, test.lat, test.lng)
+		}
+	}
+}
+
+func TestZoneNames_ShouldListCurrentZonesOnly(t *testing.T) {
+	// When listing every zone
+	names := ZoneNames()
+
+	// Then current zones should be listed once and sorted, without links
+	seen := make(map[string]bool)
+	for i, name := range names {
+		if seen[name] {
+			t.Errorf("%s listed twice", name)
+		}
+		seen[name] = true
+		if i > 0 && names[i-1] > name {
+			t.Errorf("names not sorted at %s", name)
+		}
+	}
+	if !seen["Asia/Kolkata"] || !seen["America/Sao_Paulo"] || !seen["Etc/UTC"] || !seen["Etc/GMT+5"] {
+		t.Error("expected Asia/Kolkata, America/Sao_Paulo, Etc/UTC and Etc/GMT+5 to be listed")
+	}
+	if seen["Factory"] {
+		t.Error("expected the Factory placeholder to be left out")
+	}
+	if seen["Asia/Calcutta"] || seen["US/Pacific"] || seen["Europe/Vatican"] {
+		t.Error("expected links to be left out")
+	}
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ZoneMatch is a tzdb zone found by SearchZones
+type ZoneMatch struct {
+	Name  string // the current tzdb name
+	Alias string // the link name the query matched, e.g. Asia/Calcutta, if not the zone itself
+	Score int    // higher is better
+}
+
+// Scores for how a query matches one of a zone's search terms, best first; within a
+// kind, shorter terms rank higher
+const (
+	zoneScoreExact      = 1000
+	zoneScorePrefix     = 800
+	zoneScoreWordPrefix = 600
+	zoneScoreSubstring  = 400
+	zoneScoreFuzzy      = 200
+	zoneScoreAlias      = 5 // subtracted so a zone's own name beats a link to it
+)
+
+// zoneTerm is one normalized string a zone can be found by
+type zoneTerm struct {
+	text  string
+	alias string
+}
+
+var (
+	zoneIndexOnce sync.Once
+	zoneIndex     map[string][]zoneTerm // current zone name → its search terms
+)
+
+// loadZoneIndex indexes every zone in ZoneNames under its full name and exemplar city,
+// and under the full and last names of its links
+func loadZoneIndex() {
+	zoneIndex = make(map[string][]zoneTerm)
+
+	add := func(zone, text, alias string) {
+		term := zoneTerm{text: normalizeZoneTerm(text), alias: alias}
+		for _, existing := range zoneIndex[zone] {
+			if existing == term {
+				return
+			}
+		}
+		zoneIndex[zone] = append(zoneIndex[zone], term)
+	}
+
+	for _, zone := range ZoneNames() {
+		add(zone, zone, "")
+		add(zone, ExemplarCity(zone), "")
+	}
+	for link, zone := range zoneLinks {
+		add(zone, link, link)
+		add(zone, lastZoneComponent(link), link)
+	}
+}
+
+// SearchZones ranks tzdb zones against a partial or misspelled query such as "kolk",
+// "sao paulo" or "los_ang", by prefix, substring and then edit distance over zone names,
+// exemplar cities and link names. It returns at most limit matches, or all when limit <= 0
+func SearchZones(query string, limit int) []ZoneMatch {
+	q := normalizeZoneTerm(query)
+	if q == "" {
+		return nil
+	}
+	zoneIndexOnce.Do(loadZoneIndex)
+
+	var matches []ZoneMatch
+	for zone, terms := range zoneIndex {
+		best := ZoneMatch{Name: zone}
+		for _, term := range terms {
+			score := scoreZoneTerm(q, term.text)
+			if score > 0 && term.alias != "" {
+				score -= zoneScoreAlias
+			}
+			if score > best.Score {
+				best.Score, best.Alias = score, term.alias
+			}
+		}
+		if best.Score > 0 {
+			matches = append(matches, best)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Name < matches[j].Name
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// scoreZoneTerm scores how well a normalized query matches a normalized term, 0 for no match
+func scoreZoneTerm(q, term string) int {
+	extra := len(term) - len(q)
+	if extra > 99 {
+		extra = 99
+	}
+	switch {
+	case term == q:
+		return zoneScoreExact
+	case strings.HasPrefix(term, q):
+		return zoneScorePrefix - extra
+	case strings.Contains(" "+strings.ReplaceAll(term, "/", " "), " "+q):
+		return zoneScoreWordPrefix - extra
+	case len(q) >= 3 && strings.Contains(term, q):
+		return zoneScoreSubstring - extra
+	}
+
+	// Allow one typo per four letters, comparing whole terms only so that
+	// short queries do not match everything
+	if len(q) < 4 {
+		return 0
+	}
+	if d := editDistance(q, term); d <= len(q)/4 {
+		return zoneScoreFuzzy - 50*d
+	}
+	return 0
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// zoneTermSeparators are the characters zone names use in place of spaces
+var zoneTermSeparators = strings.NewReplacer("_", " ", "-", " ")
+
+// normalizeZoneTerm folds s like FoldPlaceName and treats underscores and hyphens
+// as spaces, so "São_Paulo" and "sao paulo" compare equal
+func normalizeZoneTerm(s string) string {
+	return FoldPlaceName(zoneTermSeparators.Replace(s))
+}
+
+// lastZoneComponent returns the city part of a zone name, e.g. Calcutta for Asia/Calcutta
+func lastZoneComponent(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "testing"
+
+func TestSearchZones_ShouldRankPartialAndMisspelledQueries(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"kolk", "Asia/Kolkata"},
+		{"sao paulo", "America/Sao_Paulo"},
+		{"São Paulo", "America/Sao_Paulo"},
+		{"los_ang", "America/Los_Angeles"},
+		{"america/new", "America/New_York"},
+		{"angeles", "America/Los_Angeles"},
+		{"kolkatta", "Asia/Kolkata"},
+		{"singapur", "Asia/Singapore"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			// When searching for a partial or misspelled zone
+			matches := SearchZones(tt.query, 5)
+
+			// Then the intended zone should come first
+			if len(matches) == 0 || matches[0].Name != tt.want {
+				t.Errorf("SearchZones(%q) = %+v, want %s first", tt.query, matches, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchZones_ShouldFindZonesByTheirLinks(t *testing.T) {
+	// Given the pre-2008 name of Kolkata
+	matches := SearchZones("calcutta", 1)
+
+	// Then the current zone should be found, recording the link that matched
+	if len(matches) != 1 || matches[0].Name != "Asia/Kolkata" || matches[0].Alias != "Asia/Calcutta" {
+		t.Errorf("expected Asia/Kolkata via Asia/Calcutta, got %+v", matches)
+	}
+}
+
+func TestSearchZones_ShouldPreferExactMatchesAndLimitResults(t *testing.T) {
+	// Given a query that is a whole city and the start of others
+	matches := SearchZones("paris", 3)
+
+	// Then the exact city should rank first and no more than the limit returned
+	if len(matches) == 0 || matches[0].Name != "Europe/Paris" || matches[0].Score != zoneScoreExact {
+		t.Errorf("expected an exact Europe/Paris match first, got %+v", matches)
+	}
+	if len(matches) > 3 {
+		t.Errorf("expected at most 3 matches, got %d", len(matches))
+	}
+}
+
+func TestSearchZones_ShouldReturnNothingForUnrelatedQueries(t *testing.T) {
+	if matches := SearchZones("qqqqzz", 5); len(matches) != 0 {
+		t.Errorf("expected no matches, got %+v", matches)
+	}
+	if matches := SearchZones("  ", 5); len(matches) != 0 {
+		t.Errorf("expected no matches for a blank query, got %+v", matches)
+	}
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// SystemClock reports the current system time
+type SystemClock struct{}
+
+// Now returns time.Now()
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock always reports the same instant, e.g. for --at queries and tests
+type FixedClock time.Time
+
+// Now returns the fixed instant
+func (c FixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// ParseInstant reads an --at expression: any timestamp domain.ParseTimestamp accepts, or a
+// local date and time or relative expression followed by a place, e.g. "2026-03-29 02:30
+// Europe/London" or "tomorrow 9am Tokyo"; without a place it is read in home, or in UTC
+// when home is nil, and relative expressions count from now
+func ParseInstant(input string, now time.Time, resolver TimezoneResolver, home *domain.Timezone) (time.Time, error) {
+	if dt, place, err := domain.ParseLocalDateTime(input); err == nil {
+		loc, err := instantLocation(place, resolver, home)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return dt.In(loc)
+	}
+
+	if ts, err := domain.ParseTimestamp(input); err == nil {
+		return ts.Time, nil
+	}
+
+	if rt, place, err := domain.ParseRelativeTime(input); err == nil {
+		loc, err := instantLocation(place, resolver, home)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return rt.Resolve(now, loc)
+	}
+
+	return time.Time{}, fmt.Errorf("invalid instant: %s (expected RFC 3339, \"YYYY-MM-DD HH:MM <place>\" or e.g. \"tomorrow 9am <place>\")", strings.TrimSpace(input))
+}
+
+// instantLocation resolves the place an --at expression is read in
+func instantLocation(place string, resolver TimezoneResolver, home *domain.Timezone) (*time.Location, error) {
+	tz := home
+	if place != "" {
+		var err error
+		if tz, err = resolver.ResolveTimezone(place); err != nil {
+			return nil, err
+		}
+	}
+	if tz == nil {
+		return time.UTC, nil
+	}
+	return tz.Location()
+}
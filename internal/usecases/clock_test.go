@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+func TestParseInstant(t *testing.T) {
+	home, _ := domain.NewTimezone("America/New_York")
+	now := time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"2026-03-29T01:30:00Z", time.Date(2026, time.March, 29, 1, 30, 0, 0, time.UTC)},
+		{"2026-03-29 02:30 London", time.Date(2026, time.March, 29, 1, 30, 0, 0, time.UTC)},
+		{"2026-03-29 02:30 Tokyo", time.Date(2026, time.March, 28, 17, 30, 0, 0, time.UTC)},
+		{"2026-06-10 12:00", time.Date(2026, time.June, 10, 16, 0, 0, 0, time.UTC)},
+		{"1781100000", time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC)},
+		{"in 3 hours", time.Date(2026, time.June, 10, 17, 0, 0, 0, time.UTC)},
+		{"tomorrow 9am", time.Date(2026, time.June, 11, 13, 0, 0, 0, time.UTC)},
+		{"next monday noon Tokyo", time.Date(2026, time.June, 15, 3, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got, err := ParseInstant(test.input, now, newMockResolver(), home)
+		if err != nil {
+			t.Errorf("for %q, unexpected error: %v", test.input, err)
+			continue
+		}
+		if !got.Equal(test.expected) {
+			t.Errorf("for %q, expected %v, got %v", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestParseInstant_ShouldRejectTimesSkippedByDST(t *testing.T) {
+	// Given a wall clock time inside New York's spring-forward gap
+	_, err := ParseInstant("2026-03-08 02:30 NYC", time.Now(), newMockResolver(), nil)
+
+	// Then it should be reported rather than shifted
+	if err == nil {
+		t.Fatal("expected error for non-existent local time")
+	}
+}
+
+func TestTimeinUseCase_ShouldUseInjectedClock(t *testing.T) {
+	// Given a timein use case with a fixed clock
+	at := time.Date(2026, time.March, 29, 1, 30, 0, 0, time.UTC)
+	formatter := &MockFormatter{}
+	uc := NewTimeinUseCase(formatter).WithClock(FixedClock(at))
+
+	// When getting timezone information
+	if _, err := uc.GetTimezoneInfo("Australia/Sydney"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info, err := uc.GetTimezoneInfoForFormatting("Australia/Sydney")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the formatter and the info should both use the fixed instant
+	if !formatter.lastInstant.Equal(at) {
+		t.Errorf("expected formatter to receive %v, got %v", at, formatter.lastInstant)
+	}
+	if got := info.CurrentTime.Format("2006-01-02 15:04 MST"); got != "2026-03-29 12:30 AEDT" {
+		t.Errorf("expected 2026-03-29 12:30 AEDT, got %s", got)
+	}
+}
+
+func TestWorldClockUseCase_ShouldUseInjectedClock(t *testing.T) {
+	// Given a world clock with a fixed clock
+	at := time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC)
+	uc := NewWorldClockUseCase(&MockWorldClockFormatter{}).WithClock(FixedClock(at))
+
+	// When evaluating two zones
+	infos, err := uc.GetWorldClockInfo([]string{"Europe/London", "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then both rows should show the fixed instant
+	for _, info := range infos {
+		if !info.CurrentTime.Equal(at) {
+			t.Errorf("expected %v for %s, got %v", at, info.Timezone, info.CurrentTime)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// Conversion represents one instant shown in a source and a target timezone
+type Conversion struct {
+	Source *TimezoneInfo
+	Target *TimezoneInfo
+}
+
+// ConvertUseCase handles converting a wall clock time between places
+type ConvertUseCase struct {
+	resolver  TimezoneResolver
+	formatter ConversionFormatter
+	clock     Clock
+}
+
+// NewConvertUseCase creates a new ConvertUseCase
+func NewConvertUseCase(resolver TimezoneResolver, formatter ConversionFormatter) *ConvertUseCase {
+	return &ConvertUseCase{
+		resolver:  resolver,
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock makes conversions fall on the clock's day instead of today
+func (uc *ConvertUseCase) WithClock(clock Clock) *ConvertUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// Convert parses and formats a query like "3pm London in Tokyo"
+func (uc *ConvertUseCase) Convert(query string) ([]byte, error) {
+	conversion, err := uc.ConvertTime(query)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatConversion(conversion)
+}
+
+// ConvertTime resolves both places of a conversion query and computes the instant in each
+func (uc *ConvertUseCase) ConvertTime(query string) (*Conversion, error) {
+	q, err := domain.ParseConversionQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := uc.resolver.ResolveTimezone(q.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.resolver.ResolveTimezone(q.To)
+	if err != nil {
+		return nil, err
+	}
+
+	fromLoc, err := from.Location()
+	if err != nil {
+		return nil, err
+	}
+	toLoc, err := to.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	instant := uc.clock.Now().In(fromLoc)
+	if q.When != nil {
+		if instant, err = q.When.Resolve(instant, fromLoc); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Conversion{
+		Source: newTimezoneInfo(from, instant),
+		Target: newTimezoneInfo(to, instant.In(toLoc)),
+	}, nil
+}
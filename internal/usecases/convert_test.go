@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// MockResolver maps place names to timezones for testing
+type MockResolver struct {
+	zones map[string]string
+}
+
+func (m *MockResolver) ResolveTimezone(query string) (*domain.Timezone, error) {
+	if tz, ok := m.zones[strings.ToLower(query)]; ok {
+		return domain.NewTimezone(tz)
+	}
+	return nil, fmt.Errorf("could not geocode: %s", query)
+}
+
+// MockConversionFormatter records conversions for testing
+type MockConversionFormatter struct {
+	conversion *Conversion
+	lastError  string
+}
+
+func (m *MockConversionFormatter) FormatConversion(conversion *Conversion) ([]byte, error) {
+	m.conversion = conversion
+	return []byte("mock conversion"), nil
+}
+
+func (m *MockConversionFormatter) FormatError(message string) ([]byte, error) {
+	m.lastError = message
+	return []byte("mock error"), nil
+}
+
+func newMockResolver() *MockResolver {
+	return &MockResolver{zones: map[string]string{
+		"london": "Europe/London",
+		"tokyo":  "Asia/Tokyo",
+		"nyc":    "America/New_York",
+	}}
+}
+
+func TestConvertUseCase_ShouldConvertWallClockBetweenPlaces(t *testing.T) {
+	// Given a convert use case
+	formatter := &MockConversionFormatter{}
+	uc := NewConvertUseCase(newMockResolver(), formatter)
+
+	// When converting 3pm London to Tokyo
+	output, err := uc.Convert("3pm London in Tokyo")
+
+	// Then it should format a conversion
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(output) != "mock conversion" {
+		t.Errorf("expected conversion output, got '%s'", string(output))
+	}
+
+	// And the source should be 3pm local time
+	source := formatter.conversion.Source
+	if source.CurrentTime.Hour() != 15 || source.CurrentTime.Minute() != 0 {
+		t.Errorf("expected source 15:00, got %s", source.CurrentTime.Format("15:04"))
+	}
+
+	// And the target should be the same instant in Tokyo
+	target := formatter.conversion.Target
+	if !target.CurrentTime.Equal(source.CurrentTime) {
+		t.Errorf("expected the same instant, got %v and %v", source.CurrentTime, target.CurrentTime)
+	}
+	if target.Timezone.String() != "Asia/Tokyo" || target.City != "Tokyo" {
+		t.Errorf("unexpected target %s (%s)", target.Timezone, target.City)
+	}
+	if target.CurrentTime.Location().String() != "Asia/Tokyo" {
+		t.Errorf("expected target time in Asia/Tokyo, got %s", target.CurrentTime.Location())
+	}
+}
+
+func TestConvertUseCase_ShouldReportUnresolvablePlaces(t *testing.T) {
+	// Given a convert use case
+	formatter := &MockConversionFormatter{}
+	uc := NewConvertUseCase(newMockResolver(), formatter)
+
+	// When converting to an unknown place
+	_, err := uc.Convert("3pm London in Atlantis")
+
+	// Then it should fail with a user-facing error
+	if err == nil {
+		t.Fatal("expected error for unknown place")
+	}
+	if !strings.Contains(formatter.lastError, "Atlantis") {
+		t.Errorf("expected error to mention the place, got '%s'", formatter.lastError)
+	}
+}
+
+func TestGeotzUseCase_ResolveTimezone_ShouldSkipGeocoderForZoneNames(t *testing.T) {
+	// Given a geotz use case whose geocoder always fails
+	uc := NewGeotzUseCase(&MockGeocoder{shouldFail: true}, &MockTimezoneFinder{}, NewMockCache(), &MockFormatter{})
+
+	// When resolving an IANA name
+	tz, err := uc.ResolveTimezone("Asia/Tokyo")
+
+	// Then it should resolve without geocoding
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tz.String() != "Asia/Tokyo" {
+		t.Errorf("expected Asia/Tokyo, got %s", tz)
+	}
+}
+
+func TestConvertUseCase_ShouldResolveRelativeTimesFromClock(t *testing.T) {
+	// Given a convert use case on Wednesday 10 June 2026
+	formatter := &MockConversionFormatter{}
+	clock := FixedClock(time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC))
+	uc := NewConvertUseCase(newMockResolver(), formatter).WithClock(clock)
+
+	// When converting next Monday noon in London to Tokyo
+	conversion, err := uc.ConvertTime("next Monday noon London in Tokyo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the source should be Monday 15 June at 12:00 in London
+	if got := conversion.Source.CurrentTime.Format("Mon 2006-01-02 15:04"); got != "Mon 2026-06-15 12:00" {
+		t.Errorf("expected Mon 2026-06-15 12:00, got %s", got)
+	}
+}
+
+func TestConvertUseCase_ShouldReportAmbiguousLocalTimes(t *testing.T) {
+	// Given a convert use case the day before London's clocks go back
+	formatter := &MockConversionFormatter{}
+	clock := FixedClock(time.Date(2026, time.October, 24, 12, 0, 0, 0, time.UTC))
+	uc := NewConvertUseCase(newMockResolver(), formatter).WithClock(clock)
+
+	// When converting a time that happens twice
+	_, err := uc.Convert("1:30 tomorrow London in Tokyo")
+
+	// Then both readings should be offered instead of picking one silently
+	var ambiguous *domain.AmbiguousTimeError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected AmbiguousTimeError, got %v", err)
+	}
+	if !strings.Contains(formatter.lastError, "BST") || !strings.Contains(formatter.lastError, "GMT") {
+		t.Errorf("expected both offsets in the error, got '%s'", formatter.lastError)
+	}
+}
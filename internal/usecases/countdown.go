@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// Countdown is the time left until a wall clock time in another place
+type Countdown struct {
+	Target      *TimezoneInfo // the target instant in the target place
+	Now         time.Time
+	Remaining   time.Duration
+	Transitions []domain.Transition // UTC-offset changes in the target place before the target
+}
+
+// CountdownUseCase handles counting down to a local time in a place
+type CountdownUseCase struct {
+	resolver  TimezoneResolver
+	formatter CountdownFormatter
+	clock     Clock
+	home      *domain.Timezone
+}
+
+// NewCountdownUseCase creates a new CountdownUseCase
+func NewCountdownUseCase(resolver TimezoneResolver, formatter CountdownFormatter) *CountdownUseCase {
+	return &CountdownUseCase{
+		resolver:  resolver,
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock makes the countdown run from the clock's instant instead of now
+func (uc *CountdownUseCase) WithClock(clock Clock) *CountdownUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// WithHomeTimezone reads queries that name no place, such as "until 17:00", in home
+func (uc *CountdownUseCase) WithHomeTimezone(home *domain.Timezone) *CountdownUseCase {
+	uc.home = home
+	return uc
+}
+
+// GetCountdown formats the time left until a query like "9am Tokyo"
+func (uc *CountdownUseCase) GetCountdown(query string) ([]byte, error) {
+	countdown, err := uc.FindCountdown(query)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatCountdown(countdown)
+}
+
+// FindCountdown parses a query such as "9am Tokyo", "until Friday 17:00 London" or
+// "2026-12-31 23:59 Sydney" and measures the time to the next such wall clock time in
+// that place; the duration is between instants, so DST changes in between count
+func (uc *CountdownUseCase) FindCountdown(query string) (*Countdown, error) {
+	query = strings.TrimSpace(query)
+	for _, word := range []string{"until ", "till ", "til "} {
+		if len(query) > len(word) && strings.EqualFold(query[:len(word)], word) {
+			query = strings.TrimSpace(query[len(word):])
+			break
+		}
+	}
+	if query == "" {
+		return nil, fmt.Errorf("countdown query required, e.g. \"9am Tokyo\"")
+	}
+
+	now := uc.clock.Now()
+	var target time.Time
+	var tz *domain.Timezone
+	if dt, place, err := domain.ParseLocalDateTime(query); err == nil {
+		if tz, err = uc.resolvePlace(place); err != nil {
+			return nil, err
+		}
+		loc, err := tz.Location()
+		if err != nil {
+			return nil, err
+		}
+		if target, err = dt.In(loc); err != nil {
+			return nil, err
+		}
+	} else if rt, place, err := domain.ParseRelativeTime(query); err == nil {
+		if tz, err = uc.resolvePlace(place); err != nil {
+			return nil, err
+		}
+		loc, err := tz.Location()
+		if err != nil {
+			return nil, err
+		}
+		if target, err = rt.Next(now, loc); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, fmt.Errorf("invalid countdown: %s (expected e.g. \"9am Tokyo\" or \"Friday 17:00 London\")", query)
+	}
+
+	if !target.After(now) {
+		return nil, fmt.Errorf("%s in %s has already passed", target.Format("Mon 02 Jan 2006 15:04"), tz.City())
+	}
+	transitions, err := tz.Transitions(now, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Countdown{
+		Target:      newTimezoneInfo(tz, target),
+		Now:         now,
+		Remaining:   target.Sub(now),
+		Transitions: transitions,
+	}, nil
+}
+
+// resolvePlace resolves the place a countdown is read in, falling back to home
+func (uc *CountdownUseCase) resolvePlace(place string) (*domain.Timezone, error) {
+	if strings.TrimSpace(place) != "" {
+		return uc.resolver.ResolveTimezone(place)
+	}
+	if uc.home == nil {
+		return nil, fmt.Errorf("a city or timezone is required, e.g. \"9am Tokyo\"")
+	}
+	return uc.home, nil
+}
@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+)
+
+// MockCountdownFormatter records countdowns for testing
+type MockCountdownFormatter struct {
+	countdown         *Countdown
+	formatErrorCalled bool
+}
+
+func (m *MockCountdownFormatter) FormatCountdown(countdown *Countdown) ([]byte, error) {
+	m.countdown = countdown
+	return []byte("mock countdown"), nil
+}
+
+func (m *MockCountdownFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+func TestCountdownUseCase_ShouldCountDownToTheNextWallClockTime(t *testing.T) {
+	// Given 10:00 in Tokyo, after 9am has passed there
+	formatter := &MockCountdownFormatter{}
+	now := time.Date(2026, time.June, 10, 1, 0, 0, 0, time.UTC)
+	uc := NewCountdownUseCase(newMockResolver(), formatter).WithClock(FixedClock(now))
+
+	// When counting down to 9am Tokyo
+	_, err := uc.GetCountdown("until 9am Tokyo")
+
+	// Then tomorrow's 9am should be 23 hours away
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	countdown := formatter.countdown
+	if countdown.Remaining != 23*time.Hour {
+		t.Errorf("expected 23h, got %s", countdown.Remaining)
+	}
+	if got := countdown.Target.CurrentTime.Format("2006-01-02 15:04 MST"); got != "2026-06-11 09:00 JST" {
+		t.Errorf("expected 2026-06-11 09:00 JST, got %s", got)
+	}
+}
+
+func TestCountdownUseCase_ShouldAccountForDSTShiftsInBetween(t *testing.T) {
+	// Given Friday 23 October 2026 at 17:00 in London, before clocks go back on Sunday
+	now := time.Date(2026, time.October, 23, 16, 0, 0, 0, time.UTC)
+	uc := NewCountdownUseCase(newMockResolver(), &MockCountdownFormatter{}).WithClock(FixedClock(now))
+
+	// When counting down to next Friday 17:00 London
+	countdown, err := uc.FindCountdown("Friday 17:00 London")
+
+	// Then the week should be an hour longer and the change reported
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if countdown.Remaining != 7*24*time.Hour+time.Hour {
+		t.Errorf("expected 169h, got %s", countdown.Remaining)
+	}
+	if len(countdown.Transitions) != 1 || !countdown.Transitions[0].EndsDST() {
+		t.Errorf("expected DST to end on the way, got %+v", countdown.Transitions)
+	}
+}
+
+func TestCountdownUseCase_ShouldSkipTimesADSTGapRemoves(t *testing.T) {
+	// Given Saturday 28 March 2026 in London, the day before 01:00 jumps to 02:00
+	now := time.Date(2026, time.March, 28, 12, 0, 0, 0, time.UTC)
+	uc := NewCountdownUseCase(newMockResolver(), &MockCountdownFormatter{}).WithClock(FixedClock(now))
+
+	// When counting down to 1:30 London, which does not exist on Sunday
+	countdown, err := uc.FindCountdown("until 1:30 London")
+
+	// Then Monday's 1:30 should be the target
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := countdown.Target.CurrentTime.Format("2006-01-02 15:04 MST"); got != "2026-03-30 01:30 BST" {
+		t.Errorf("expected 2026-03-30 01:30 BST, got %s", got)
+	}
+	if countdown.Remaining != 36*time.Hour+30*time.Minute {
+		t.Errorf("expected 36h30m, got %s", countdown.Remaining)
+	}
+}
+
+func TestCountdownUseCase_ShouldRejectPassedDatesAndMissingPlaces(t *testing.T) {
+	// Given a countdown use case without a home timezone
+	formatter := &MockCountdownFormatter{}
+	now := time.Date(2026, time.June, 10, 1, 0, 0, 0, time.UTC)
+	uc := NewCountdownUseCase(newMockResolver(), formatter).WithClock(FixedClock(now))
+
+	// When counting down to a date already passed, or naming no place
+	if _, err := uc.FindCountdown("2026-01-01 00:00 London"); err == nil {
+		t.Error("expected an error for a passed date")
+	}
+	_, err := uc.GetCountdown("until 17:00")
+
+	// Then errors should be formatted for the user
+	if err == nil || !formatter.formatErrorCalled {
+		t.Errorf("expected a formatted error, got %v", err)
+	}
+}
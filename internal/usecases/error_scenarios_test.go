@@ -3,6 +3,7 @@ package usecases
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/loginx/alfred-timein/internal/domain"
 )
@@ -21,7 +22,7 @@ func (m *MockFailingFormatter) FormatTimezoneInfo(timezone *domain.Timezone, cit
 	return []byte("formatted timezone info"), nil
 }
 
-func (m *MockFailingFormatter) FormatTimeInfo(timezone *domain.Timezone) ([]byte, error) {
+func (m *MockFailingFormatter) FormatTimeInfo(timezone *domain.Timezone, at time.Time) ([]byte, error) {
 	if m.shouldFailOnTimeInfo {
 		return nil, fmt.Errorf("formatter failed on time info")
 	}
@@ -1,12 +1,17 @@
 package usecases
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/loginx/alfred-timein/internal/domain"
 )
 
+// geocodeCandidateLimit is how many places a query is geocoded to before the distinct
+// timezones among them are offered
+const geocodeCandidateLimit = 5
+
 // GeotzUseCase handles geocoding to timezone conversion
 type GeotzUseCase struct {
 	geocoder        Geocoder
@@ -25,47 +30,176 @@ func NewGeotzUseCase(geocoder Geocoder, timezoneFinder TimezoneFinder, cache Cac
 	}
 }
 
-// GetTimezoneFromCity converts a city name to timezone
+// GetTimezoneFromCity converts a city name to timezone; UTC offsets such as
+// "UTC+5:30" are answered directly without geocoding. When places sharing the name lie
+// in different timezones, formatters that can list them offer each one
 func (uc *GeotzUseCase) GetTimezoneFromCity(city string) ([]byte, error) {
 	city = strings.TrimSpace(city)
+	if timezone, err := domain.NewOffsetTimezone(city); err == nil {
+		return uc.formatter.FormatTimezoneInfo(timezone, city, false)
+	}
+
+	candidates, cached, err := uc.FindTimezoneCandidates(city)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+	if cf, ok := uc.formatter.(TimezoneCandidatesFormatter); ok && len(candidates) > 1 {
+		return cf.FormatTimezoneCandidates(city, candidates)
+	}
+
+	return uc.formatter.FormatTimezoneInfo(candidates[0], city, cached)
+}
+
+// ChooseTimezone remembers choice, a zone name optionally followed by the chosen place's
+// coordinates, as the answer for city, so later lookups skip the candidates
+func (uc *GeotzUseCase) ChooseTimezone(city, choice string) ([]byte, error) {
+	city = strings.TrimSpace(city)
+	timezone, err := domain.NewPlacedTimezone(choice)
+	if err == nil && city == "" {
+		err = fmt.Errorf("city or landmark argument required")
+	}
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	uc.cache.Set(CacheKey(city), timezone.PlacedName())
+	return uc.formatter.FormatTimezoneInfo(timezone, city, false)
+}
+
+// ResolveTimezone resolves an IANA timezone name, abbreviation or place name to a
+// timezone, only consulting the geocoder when the query is not already a timezone;
+// ambiguous abbreviations are reported rather than geocoded
+func (uc *GeotzUseCase) ResolveTimezone(query string) (*domain.Timezone, error) {
+	query = strings.TrimSpace(query)
+	tz, err := domain.NewPlacedTimezone(query)
+	var ambiguous *domain.AmbiguousAbbreviationError
+	if err == nil || errors.As(err, &ambiguous) {
+		return tz, err
+	}
+
+	timezone, _, err := uc.resolve(query)
+	return timezone, err
+}
+
+// resolve looks up the best timezone for a city through the cache, geocoder and
+// timezone finder
+func (uc *GeotzUseCase) resolve(city string) (*domain.Timezone, bool, error) {
+	candidates, cached, err := uc.FindTimezoneCandidates(city)
+	if err != nil {
+		return nil, false, err
+	}
+	return candidates[0], cached, nil
+}
+
+// FindTimezoneCandidates looks up the timezones of the places matching a city, best
+// first and one per timezone, through the cache, geocoder and timezone finder. The
+// geocoded coordinates are kept as each timezone's Place and cached with it; several
+// candidates are cached together until the user picks one with ChooseTimezone
+func (uc *GeotzUseCase) FindTimezoneCandidates(city string) ([]*domain.Timezone, bool, error) {
 	if city == "" {
-		output, _ := uc.formatter.FormatError("City or landmark argument required.")
-		return output, fmt.Errorf("city or landmark argument required")
+		return nil, false, fmt.Errorf("city or landmark argument required")
 	}
 
 	// Check cache first
-	cacheKey := strings.ToLower(city)
-	if tz, ok := uc.cache.Get(cacheKey); ok {
+	cacheKey := CacheKey(city)
+	if value, ok := uc.cache.Get(cacheKey); ok {
+		var candidates []*domain.Timezone
+		for _, tz := range CachedZones(value) {
+			timezone, err := domain.NewPlacedTimezone(tz)
+			if err != nil {
+				return nil, false, err
+			}
+			candidates = append(candidates, timezone)
+		}
+		return candidates, true, nil
+	}
+
+	// Coordinates need no geocoding and are not cached, as they are looked up offline;
+	// coordinates off the globe are reported rather than geocoded as a name
+	location, err := domain.ParseCoordinates(city)
+	var outOfRange *domain.CoordinatesRangeError
+	if errors.As(err, &outOfRange) {
+		return nil, false, err
+	}
+	if err == nil {
+		tz, err := uc.timezoneFinder.GetTimezoneName(location.Longitude, location.Latitude)
+		if err != nil || tz == "" {
+			return nil, false, fmt.Errorf("could not resolve timezone for: %s", city)
+		}
 		timezone, err := domain.NewTimezone(tz)
 		if err != nil {
-			output, _ := uc.formatter.FormatError(err.Error())
-			return output, err
+			return nil, false, err
 		}
-		return uc.formatter.FormatTimezoneInfo(timezone, city, true)
+		timezone.Place = location
+		return []*domain.Timezone{timezone}, false, nil
 	}
 
 	// Geocode the city
-	location, err := uc.geocoder.Geocode(city)
-	if err != nil {
-		output, _ := uc.formatter.FormatError("Could not geocode: " + city)
-		return output, fmt.Errorf("could not geocode: %s", city)
+	locations, err := uc.geocoder.GeocodeCandidates(city, geocodeCandidateLimit)
+	if err != nil || len(locations) == 0 {
+		return nil, false, fmt.Errorf("could not geocode: %s", city)
 	}
 
-	// Find timezone for the location
-	tz, err := uc.timezoneFinder.GetTimezoneName(location.Longitude, location.Latitude)
-	if err != nil || tz == "" {
-		output, _ := uc.formatter.FormatError("Could not resolve timezone for: " + city)
-		return output, fmt.Errorf("could not resolve timezone for: %s", city)
+	// Find the timezone of each place, keeping the best place in each
+	var candidates []*domain.Timezone
+	seen := make(map[string]bool)
+	for _, location := range locations {
+		tz, err := uc.timezoneFinder.GetTimezoneName(location.Longitude, location.Latitude)
+		if err != nil || tz == "" {
+			continue
+		}
+		timezone, err := domain.NewTimezone(tz)
+		if err != nil || seen[timezone.Name] {
+			continue
+		}
+		seen[timezone.Name] = true
+		timezone.Place = location
+		candidates = append(candidates, timezone)
+	}
+	if len(candidates) == 0 {
+		return nil, false, fmt.Errorf("could not resolve timezone for: %s", city)
 	}
 
-	timezone, err := domain.NewTimezone(tz)
-	if err != nil {
-		output, _ := uc.formatter.FormatError(err.Error())
-		return output, err
+	// Cache the result, every candidate until the user chooses one
+	zones := make([]string, len(candidates))
+	for i, timezone := range candidates {
+		zones[i] = timezone.PlacedName()
 	}
+	uc.cache.Set(cacheKey, strings.Join(zones, "\n"))
 
-	// Cache the result
-	uc.cache.Set(cacheKey, tz)
+	return candidates, false, nil
+}
 
-	return uc.formatter.FormatTimezoneInfo(timezone, city, false)
-}
\ No newline at end of file
+// CacheKey returns the cache key for a query: lower-cased, with deprecated zone names
+// such as Asia/Calcutta replaced by their current one
+func CacheKey(query string) string {
+	key := strings.ToLower(strings.TrimSpace(query))
+	if canonical, ok := domain.CanonicalZoneNameFold(key); ok {
+		return strings.ToLower(canonical)
+	}
+	return key
+}
+
+// CachedZones splits a cache entry into its zones, each possibly followed by coordinates:
+// one for an unambiguous query, or one per line for the candidates of an ambiguous one
+func CachedZones(value string) []string {
+	return strings.Split(value, "\n")
+}
+
+// CanonicalizeCache migrates cache entries written before zone names were canonicalized:
+// values move to current tzdb names and keys that are old zone names collapse into the
+// entry for the current one. It returns how many entries changed
+func CanonicalizeCache(cache CacheRewriter) int {
+	return cache.Rewrite(func(key, value string) (string, string) {
+		zones := CachedZones(value)
+		for i, zone := range zones {
+			name, place := domain.SplitCoordinates(zone)
+			if canonical, ok := domain.CanonicalZoneName(name); ok {
+				zones[i] = (&domain.Timezone{Name: canonical, Place: place}).PlacedName()
+			}
+		}
+		return CacheKey(key), strings.Join(zones, "\n")
+	})
+}
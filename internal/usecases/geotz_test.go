@@ -1,7 +1,9 @@
 package usecases
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/loginx/alfred-timein/internal/domain"
@@ -19,6 +21,14 @@ func (m *MockGeocoder) Geocode(query string) (*domain.Location, error) {
 	return domain.NewLocation(query, 40.7128, -74.0060)
 }
 
+func (m *MockGeocoder) GeocodeCandidates(query string, limit int) ([]*domain.Location, error) {
+	location, err := m.Geocode(query)
+	if err != nil {
+		return nil, err
+	}
+	return []*domain.Location{location}, nil
+}
+
 // MockTimezoneFinder for testing
 type MockTimezoneFinder struct {
 	shouldFail bool
@@ -55,6 +65,27 @@ func (m *MockCache) Clear() {
 	m.data = make(map[string]string)
 }
 
+func (m *MockCache) Rewrite(fn func(key, value string) (string, string)) int {
+	keys := make([]string, 0, len(m.data))
+	for k := range m.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	data := make(map[string]string, len(m.data))
+	changed := 0
+	for _, k := range keys {
+		v := m.data[k]
+		key, value := fn(k, v)
+		if _, dup := data[key]; dup || key != k || value != v {
+			changed++
+		}
+		data[key] = value
+	}
+	m.data = data
+	return changed
+}
+
 func TestGeotzUseCase_GetTimezoneFromCity_Valid(t *testing.T) {
 	formatter := &MockFormatter{}
 	geocoder := &MockGeocoder{}
@@ -72,8 +103,8 @@ func TestGeotzUseCase_GetTimezoneFromCity_Valid(t *testing.T) {
 		t.Errorf("expected 'mock timezone info', got %s", string(output))
 	}
 	
-	// Check if result was cached
-	if cached, ok := cache.Get("new york"); !ok || cached != "America/New_York" {
+	// Check if result was cached with the geocoded coordinates
+	if cached, ok := cache.Get("new york"); !ok || cached != "America/New_York 40.712800,-74.006000" {
 		t.Errorf("expected result to be cached")
 	}
 }
@@ -155,4 +186,263 @@ func TestGeotzUseCase_GetTimezoneFromCity_EmptyCity(t *testing.T) {
 	if !formatter.formatErrorCalled {
 		t.Errorf("expected FormatError to be called")
 	}
-}
\ No newline at end of file
+}
+
+func TestGeotzUseCase_ResolveTimezone_ShouldNotGeocodeAmbiguousAbbreviations(t *testing.T) {
+	// Given a geocoder that would resolve anything
+	uc := NewGeotzUseCase(&MockGeocoder{}, &MockTimezoneFinder{}, NewMockCache(), &MockFormatter{})
+
+	// When resolving an ambiguous abbreviation
+	_, err := uc.ResolveTimezone("CST")
+
+	// Then the ambiguity should be reported rather than geocoded
+	var ambiguous *domain.AmbiguousAbbreviationError
+	if !errors.As(err, &ambiguous) {
+		t.Errorf("expected an ambiguity error, got %v", err)
+	}
+}
+
+func TestGeotzUseCase_ResolveTimezone_ShouldAcceptAbbreviations(t *testing.T) {
+	uc := NewGeotzUseCase(&MockGeocoder{shouldFail: true}, &MockTimezoneFinder{}, NewMockCache(), &MockFormatter{})
+
+	tz, err := uc.ResolveTimezone("AEST")
+	if err != nil || tz.String() != "Australia/Sydney" {
+		t.Errorf("expected Australia/Sydney, got %v, %v", tz, err)
+	}
+}
+
+func TestGeotzUseCase_GetTimezoneFromCity_ShouldAnswerOffsetsWithoutGeocoding(t *testing.T) {
+	// Given a geocoder that always fails
+	cache := NewMockCache()
+	uc := NewGeotzUseCase(&MockGeocoder{shouldFail: true}, &MockTimezoneFinder{shouldFail: true}, cache, &MockFormatter{})
+
+	// When asking for a UTC offset
+	output, err := uc.GetTimezoneFromCity("GMT-3")
+
+	// Then it should be answered directly and not cached
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(output) != "mock timezone info" {
+		t.Errorf("expected timezone info, got %s", string(output))
+	}
+	if len(cache.data) != 0 {
+		t.Errorf("expected nothing cached, got %v", cache.data)
+	}
+}
+
+func TestGeotzUseCase_ResolveTimezone_ShouldKeepGeocodedCoordinates(t *testing.T) {
+	// Given a geocoder that places everything in New York
+	cache := NewMockCache()
+	uc := NewGeotzUseCase(&MockGeocoder{}, &MockTimezoneFinder{}, cache, &MockFormatter{})
+
+	// When resolving a place, then resolving it again from the cache
+	fresh, err := uc.ResolveTimezone("Brooklyn")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cached, err := NewGeotzUseCase(&MockGeocoder{shouldFail: true}, &MockTimezoneFinder{}, cache, &MockFormatter{}).ResolveTimezone("Brooklyn")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then both should carry the geocoded coordinates
+	for _, tz := range []*domain.Timezone{fresh, cached} {
+		if tz.Place == nil || tz.Place.Coordinates() != "40.712800,-74.006000" {
+			t.Errorf("expected New York coordinates, got %+v", tz.Place)
+		}
+	}
+}
+
+func TestCanonicalizeCache_ShouldMoveEntriesToCurrentNames(t *testing.T) {
+	// Given a cache written before zone names were canonicalized
+	cache := NewMockCache()
+	cache.Set("kiev", "Europe/Kiev 50.450100,30.523400")
+	cache.Set("asia/calcutta", "Asia/Calcutta")
+	cache.Set("asia/kolkata", "Asia/Kolkata")
+	cache.Set("paris", "Europe/Paris")
+	cache.Set("springfield", "US/Central 39.801050,-89.643700\nAmerica/New_York 42.101480,-72.589810")
+
+	// When migrating it
+	changed := CanonicalizeCache(cache)
+
+	// Then values use current names and the old zone-name key collapses into the new one
+	if changed != 4 {
+		t.Errorf("expected 4 changes, got %d", changed)
+	}
+	if got := cache.data["kiev"]; got != "Europe/Kyiv 50.450100,30.523400" {
+		t.Errorf("expected Kyiv with its coordinates, got %q", got)
+	}
+	if got := cache.data["springfield"]; got != "America/Chicago 39.801050,-89.643700\nAmerica/New_York 42.101480,-72.589810" {
+		t.Errorf("expected each cached candidate migrated, got %q", got)
+	}
+	if len(cache.data) != 4 || cache.data["asia/kolkata"] != "Asia/Kolkata" {
+		t.Errorf("expected asia/calcutta to collapse into asia/kolkata, got %v", cache.data)
+	}
+}
+
+func TestCacheKey_ShouldUseCurrentZoneNames(t *testing.T) {
+	if got := CacheKey("  Asia/Calcutta "); got != "asia/kolkata" {
+		t.Errorf("expected asia/kolkata, got %q", got)
+	}
+	if got := CacheKey("New York"); got != "new york" {
+		t.Errorf("expected new york, got %q", got)
+	}
+}
+
+// MockCandidateGeocoder geocodes every query to a fixed list of places
+type MockCandidateGeocoder struct {
+	locations []*domain.Location
+	calls     int
+}
+
+func (m *MockCandidateGeocoder) Geocode(query string) (*domain.Location, error) {
+	return m.locations[0], nil
+}
+
+func (m *MockCandidateGeocoder) GeocodeCandidates(query string, limit int) ([]*domain.Location, error) {
+	m.calls++
+	return m.locations, nil
+}
+
+// MockLongitudeTimezoneFinder puts places west of 100°W in Los Angeles and the rest in New York
+type MockLongitudeTimezoneFinder struct{}
+
+func (m *MockLongitudeTimezoneFinder) GetTimezoneName(longitude, latitude float64) (string, error) {
+	if longitude < -100 {
+		return "America/Los_Angeles", nil
+	}
+	return "America/New_York", nil
+}
+
+// MockCandidatesFormatter records the candidates it was asked to list
+type MockCandidatesFormatter struct {
+	MockFormatter
+	candidates []*domain.Timezone
+}
+
+func (m *MockCandidatesFormatter) FormatTimezoneCandidates(query string, candidates []*domain.Timezone) ([]byte, error) {
+	m.candidates = candidates
+	return []byte("mock candidates"), nil
+}
+
+func portlands() *MockCandidateGeocoder {
+	return &MockCandidateGeocoder{locations: []*domain.Location{
+		{Name: "Portland", Latitude: 45.52345, Longitude: -122.67621, DisplayName: "Portland, Oregon"},
+		{Name: "Portland", Latitude: 43.66147, Longitude: -70.25533, DisplayName: "Portland, Maine"},
+		{Name: "Portland", Latitude: 45.5, Longitude: -122.6, DisplayName: "Portland Airport, Oregon"},
+	}}
+}
+
+func TestGeotzUseCase_GetTimezoneFromCity_ShouldOfferEachDistinctTimezone(t *testing.T) {
+	// Given places sharing a name in two timezones
+	cache := NewMockCache()
+	geocoder := portlands()
+	formatter := &MockCandidatesFormatter{}
+	uc := NewGeotzUseCase(geocoder, &MockLongitudeTimezoneFinder{}, cache, formatter)
+
+	// When looking the name up
+	output, err := uc.GetTimezoneFromCity("Portland")
+
+	// Then one candidate per timezone should be offered, best place first
+	if err != nil || string(output) != "mock candidates" {
+		t.Fatalf("expected candidates, got %q, %v", output, err)
+	}
+	if len(formatter.candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %d", len(formatter.candidates))
+	}
+	if formatter.candidates[0].String() != "America/Los_Angeles" || formatter.candidates[0].Place.DisplayName != "Portland, Oregon" ||
+		formatter.candidates[1].String() != "America/New_York" {
+		t.Errorf("unexpected candidates %v, %v", formatter.candidates[0], formatter.candidates[1])
+	}
+
+	// And every candidate should be cached with its place and its name until the user picks one
+	candidates, cached, err := uc.FindTimezoneCandidates("portland")
+	if err != nil || !cached || geocoder.calls != 1 {
+		t.Fatalf("expected the candidates from the cache, got %v, %v after %d geocoder calls", cached, err, geocoder.calls)
+	}
+	if len(candidates) != 2 || candidates[1].PlacedName() != "America/New_York 43.661470,-70.255330 Portland, Maine" ||
+		candidates[1].Place.DisplayName != "Portland, Maine" {
+		t.Errorf("expected both cached candidates, got %v", candidates)
+	}
+}
+
+func TestGeotzUseCase_GetTimezoneFromCity_ShouldAnswerWithTheBestCandidateWhenNotListing(t *testing.T) {
+	// Given a formatter that cannot list candidates
+	uc := NewGeotzUseCase(portlands(), &MockLongitudeTimezoneFinder{}, NewMockCache(), &MockFormatter{})
+
+	// Then the best place should answer, as before
+	tz, err := uc.ResolveTimezone("Portland")
+	if err != nil || tz.String() != "America/Los_Angeles" {
+		t.Errorf("expected America/Los_Angeles, got %v, %v", tz, err)
+	}
+}
+
+func TestGeotzUseCase_ChooseTimezone_ShouldRememberTheChoiceForTheQuery(t *testing.T) {
+	// Given an ambiguous name
+	cache := NewMockCache()
+	formatter := &MockCandidatesFormatter{}
+	uc := NewGeotzUseCase(portlands(), &MockLongitudeTimezoneFinder{}, cache, formatter)
+
+	// When the user picks Maine
+	if _, err := uc.ChooseTimezone(" Portland ", "America/New_York 43.661470,-70.255330 Portland, Maine"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then later lookups should answer from the cache with the chosen place
+	candidates, cached, err := uc.FindTimezoneCandidates("portland")
+	if err != nil || !cached || len(candidates) != 1 {
+		t.Fatalf("expected a single cached answer, got %v, %v, %v", candidates, cached, err)
+	}
+	if candidates[0].String() != "America/New_York" || candidates[0].Place.Coordinates() != "43.661470,-70.255330" ||
+		candidates[0].Place.DisplayName != "Portland, Maine" {
+		t.Errorf("expected the chosen place, got %v", candidates[0].PlacedName())
+	}
+
+	// But an invalid choice should be reported and not remembered
+	if _, err := uc.ChooseTimezone("Springfield", "Mars/Olympus"); err == nil || !formatter.formatErrorCalled {
+		t.Errorf("expected an invalid choice to be reported, got %v", err)
+	}
+	if _, ok := cache.Get("springfield"); ok {
+		t.Errorf("expected the invalid choice not to be cached")
+	}
+}
+
+func TestGeotzUseCase_ResolveTimezone_ShouldLookUpCoordinatesWithoutGeocoding(t *testing.T) {
+	// Given a geocoder that always fails
+	cache := NewMockCache()
+	uc := NewGeotzUseCase(&MockGeocoder{shouldFail: true}, &MockLongitudeTimezoneFinder{}, cache, &MockFormatter{})
+
+	for _, query := range []string{"45.52,-122.68", "45°31′N 122°41′W", "geo:45.52,-122.68;u=10"} {
+		// When resolving coordinates
+		tz, err := uc.ResolveTimezone(query)
+
+		// Then the timezone should be looked up at those coordinates
+		if err != nil {
+			t.Fatalf("ResolveTimezone(%q) failed: %v", query, err)
+		}
+		if tz.Name != "America/Los_Angeles" || tz.Place == nil || tz.Place.Longitude > -122 {
+			t.Errorf("ResolveTimezone(%q) = %s at %+v, want America/Los_Angeles", query, tz.Name, tz.Place)
+		}
+	}
+
+	// And nothing should be cached
+	if len(cache.data) != 0 {
+		t.Errorf("expected nothing cached, got %v", cache.data)
+	}
+}
+
+func TestGeotzUseCase_FindTimezoneCandidates_ShouldReportCoordinatesOutOfRange(t *testing.T) {
+	// Given a geocoder that would place anything
+	geocoder := portlands()
+	uc := NewGeotzUseCase(geocoder, &MockLongitudeTimezoneFinder{}, NewMockCache(), &MockFormatter{})
+
+	// When looking up coordinates off the globe
+	_, _, err := uc.FindTimezoneCandidates("91,0")
+
+	// Then the range should be reported instead of geocoding them as a name
+	var outOfRange *domain.CoordinatesRangeError
+	if !errors.As(err, &outOfRange) || geocoder.calls != 0 {
+		t.Errorf("expected CoordinatesRangeError without geocoding, got %v after %d calls", err, geocoder.calls)
+	}
+}
@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// HistoryRequest asks for a zone's offset and abbreviation history
+type HistoryRequest struct {
+	Zone string
+	From string // YYYY, YYYY-MM or YYYY-MM-DD; default the zone's earliest record
+	To   string // YYYY, YYYY-MM or YYYY-MM-DD, inclusive; default a year from now
+	At   string // a date or instant; report only the period in effect then
+}
+
+// ZoneHistory lists the changes of a zone within a range, or the period in effect at an instant
+type ZoneHistory struct {
+	Timezone *domain.Timezone
+	From     time.Time
+	To       time.Time
+	Changes  []domain.Transition
+	At       time.Time          // zero unless reporting a single period
+	Period   *domain.ZonePeriod // in effect at At
+	Now      time.Time
+}
+
+// HistoryUseCase handles reporting the historical offsets of a zone
+type HistoryUseCase struct {
+	resolver  TimezoneResolver
+	formatter HistoryFormatter
+	clock     Clock
+}
+
+// NewHistoryUseCase creates a new HistoryUseCase
+func NewHistoryUseCase(resolver TimezoneResolver, formatter HistoryFormatter) *HistoryUseCase {
+	return &HistoryUseCase{
+		resolver:  resolver,
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock makes the default end of the range and relative --at expressions count from the clock's instant
+func (uc *HistoryUseCase) WithClock(clock Clock) *HistoryUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// GetHistory formats a zone's history
+func (uc *HistoryUseCase) GetHistory(req HistoryRequest) ([]byte, error) {
+	history, err := uc.FindHistory(req)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatHistory(history)
+}
+
+// FindHistory resolves the zone and walks its tzdata transitions
+func (uc *HistoryUseCase) FindHistory(req HistoryRequest) (*ZoneHistory, error) {
+	if strings.TrimSpace(req.Zone) == "" {
+		return nil, fmt.Errorf("IANA timezone argument required")
+	}
+	tz, err := uc.resolver.ResolveTimezone(req.Zone)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now().In(loc)
+	history := &ZoneHistory{Timezone: tz, Now: now}
+
+	if strings.TrimSpace(req.At) != "" {
+		if req.From != "" || req.To != "" {
+			return nil, fmt.Errorf("--at cannot be combined with --from or --to")
+		}
+		if history.At, err = uc.historyInstant(req.At, now, tz, loc); err != nil {
+			return nil, err
+		}
+		history.At = history.At.In(loc)
+		if history.Period, err = tz.PeriodAt(history.At); err != nil {
+			return nil, err
+		}
+		return history, nil
+	}
+
+	history.From = time.Time{}.In(loc)
+	if req.From != "" {
+		start, _, err := domain.ParseDatePeriod(req.From)
+		if err != nil {
+			return nil, err
+		}
+		history.From = midnight(start, loc)
+	}
+	history.To = now.AddDate(1, 0, 0)
+	if req.To != "" {
+		_, end, err := domain.ParseDatePeriod(req.To)
+		if err != nil {
+			return nil, err
+		}
+		history.To = midnight(end, loc)
+	}
+	if !history.To.After(history.From) {
+		return nil, fmt.Errorf("end of range must be after its start")
+	}
+
+	if history.Changes, err = tz.History(history.From, history.To); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// historyInstant reads --at as a date, taken at its first moment in the zone, or as any
+// instant ParseInstant accepts, read in the zone unless it names another place
+func (uc *HistoryUseCase) historyInstant(input string, now time.Time, tz *domain.Timezone, loc *time.Location) (time.Time, error) {
+	if start, _, err := domain.ParseDatePeriod(input); err == nil {
+		return midnight(start, loc), nil
+	}
+	return ParseInstant(input, now, uc.resolver, tz)
+}
+
+// midnight returns the start of a date in loc
+func midnight(dt domain.LocalDateTime, loc *time.Location) time.Time {
+	return time.Date(dt.Year, dt.Month, dt.Day, 0, 0, 0, 0, loc)
+}
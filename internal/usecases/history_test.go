@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+)
+
+// MockHistoryFormatter records zone histories for testing
+type MockHistoryFormatter struct {
+	history           *ZoneHistory
+	formatErrorCalled bool
+}
+
+func (m *MockHistoryFormatter) FormatHistory(history *ZoneHistory) ([]byte, error) {
+	m.history = history
+	return []byte("mock history"), nil
+}
+
+func (m *MockHistoryFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+func TestHistoryUseCase_ShouldListChangesInRange(t *testing.T) {
+	// Given a history use case
+	formatter := &MockHistoryFormatter{}
+	uc := NewHistoryUseCase(newMockResolver(), formatter)
+
+	// When listing London from 2025 to 2026
+	_, err := uc.GetHistory(HistoryRequest{Zone: "London", From: "2025", To: "2026"})
+
+	// Then both years' DST changes should be reported in order
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changes := formatter.history.Changes
+	if len(changes) != 4 {
+		t.Fatalf("expected 4 changes, got %d", len(changes))
+	}
+	if !changes[0].StartsDST() || !changes[3].EndsDST() {
+		t.Errorf("expected DST start first and DST end last, got %+v", changes)
+	}
+}
+
+func TestHistoryUseCase_ShouldReportPeriodAtDate(t *testing.T) {
+	// Given a history use case
+	clock := FixedClock(time.Date(2026, time.June, 10, 14, 0, 0, 0, time.UTC))
+	uc := NewHistoryUseCase(newMockResolver(), &MockHistoryFormatter{}).WithClock(clock)
+
+	// When asking for New York on a winter date
+	history, err := uc.FindHistory(HistoryRequest{Zone: "NYC", At: "2010-01-15"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the standard time period in effect then should be reported
+	if history.Period == nil || history.Period.Abbreviation != "EST" || history.Period.IsDST {
+		t.Errorf("expected EST, got %+v", history.Period)
+	}
+	if got := history.At.Format("2006-01-02 15:04 MST"); got != "2010-01-15 00:00 EST" {
+		t.Errorf("expected midnight in New York, got %s", got)
+	}
+}
+
+func TestHistoryUseCase_ShouldRejectAtWithRange(t *testing.T) {
+	// Given a history use case
+	formatter := &MockHistoryFormatter{}
+	uc := NewHistoryUseCase(newMockResolver(), formatter)
+
+	// When combining --at with --from
+	_, err := uc.GetHistory(HistoryRequest{Zone: "Tokyo", From: "2010", At: "2013"})
+
+	// Then an error should be formatted
+	if err == nil || !formatter.formatErrorCalled {
+		t.Errorf("expected formatted error, got %v", err)
+	}
+}
@@ -1,12 +1,16 @@
 package usecases
 
 import (
+	"time"
+
 	"github.com/loginx/alfred-timein/internal/domain"
 )
 
 // Geocoder defines the interface for geocoding services
 type Geocoder interface {
 	Geocode(query string) (*domain.Location, error)
+	// GeocodeCandidates returns up to limit places matching the query, best first
+	GeocodeCandidates(query string, limit int) ([]*domain.Location, error)
 }
 
 // TimezoneFinder defines the interface for timezone lookup services
@@ -21,9 +25,109 @@ type Cache interface {
 	Clear()
 }
 
+// CacheLister defines the interface for caches that can list their live values
+type CacheLister interface {
+	Values() []string
+}
+
+// CacheRewriter defines the interface for caches whose entries can be migrated in place
+type CacheRewriter interface {
+	Rewrite(fn func(key, value string) (string, string)) int
+}
+
+// TzdataSource defines the interface for a copy of the IANA tz database
+type TzdataSource interface {
+	Name() string
+	Version() string // the tzdata release, or "" when unknown
+	LoadLocation(name string) (*time.Location, error)
+}
+
+// DatasetVersioner defines the interface for services that report the release of their bundled dataset
+type DatasetVersioner interface {
+	DataVersion() (string, error)
+}
+
 // OutputFormatter defines the interface for output formatting
 type OutputFormatter interface {
 	FormatTimezoneInfo(timezone *domain.Timezone, city string, cached bool) ([]byte, error)
-	FormatTimeInfo(timezone *domain.Timezone) ([]byte, error)
+	FormatTimeInfo(timezone *domain.Timezone, at time.Time) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// TimezoneResolver defines the interface for resolving places or zone names to timezones
+type TimezoneResolver interface {
+	ResolveTimezone(query string) (*domain.Timezone, error)
+}
+
+// ConversionFormatter defines the interface for formatting time conversions
+type ConversionFormatter interface {
+	FormatConversion(conversion *Conversion) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// WorldClockFormatter defines the interface for formatting several zones at once
+type WorldClockFormatter interface {
+	FormatWorldClock(infos []*TimezoneInfo) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// OverlapFormatter defines the interface for formatting meeting overlaps
+type OverlapFormatter interface {
+	FormatOverlap(overlap *Overlap) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// TransitionFormatter defines the interface for formatting UTC-offset transitions
+type TransitionFormatter interface {
+	FormatTransitions(report *TransitionReport) ([]byte, error)
 	FormatError(message string) ([]byte, error)
-}
\ No newline at end of file
+}
+
+// ParseFormatter defines the interface for formatting parsed timestamps
+type ParseFormatter interface {
+	FormatParsedTimestamp(parsed *ParsedTimestamp) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// HistoryFormatter defines the interface for formatting a zone's offset history
+type HistoryFormatter interface {
+	FormatHistory(history *ZoneHistory) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// TzdataFormatter defines the interface for formatting tzdata versions and rule comparisons
+type TzdataFormatter interface {
+	FormatVersion(report *VersionReport) ([]byte, error)
+	FormatTzdataDiff(diff *TzdataDiff) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// TimezoneCandidatesFormatter defines the interface for formatters that let the user pick
+// between the timezones of places sharing a name
+type TimezoneCandidatesFormatter interface {
+	FormatTimezoneCandidates(query string, candidates []*domain.Timezone) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// ZoneSearchFormatter defines the interface for formatting zone suggestions
+type ZoneSearchFormatter interface {
+	FormatZoneSearch(search *ZoneSearch) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// ZoneListFormatter defines the interface for formatting zones grouped by offset
+type ZoneListFormatter interface {
+	FormatZoneList(list *ZoneList) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// CountdownFormatter defines the interface for formatting countdowns to a local time
+type CountdownFormatter interface {
+	FormatCountdown(countdown *Countdown) ([]byte, error)
+	FormatError(message string) ([]byte, error)
+}
+
+// Clock defines the interface for the instant use cases treat as now
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// ParticipantRequest describes one participant of an overlap query
+type ParticipantRequest struct {
+	Place string
+	Hours *domain.WorkingHours // nil means the default working hours
+}
+
+// OverlapRequest describes a meeting overlap query over whole days
+type OverlapRequest struct {
+	Participants []ParticipantRequest
+	From         string // first day as YYYY-MM-DD in the first participant's timezone; empty for today
+	To           string // last day as YYYY-MM-DD; when empty Days is used
+	Days         int
+}
+
+// Overlap represents the time slots shared by every participant's working hours
+type Overlap struct {
+	Participants []*domain.Participant
+	Slots        []domain.TimeSlot
+	From         time.Time
+	To           time.Time
+}
+
+// OverlapUseCase handles finding meeting slots across cities' working hours
+type OverlapUseCase struct {
+	resolver  TimezoneResolver
+	formatter OverlapFormatter
+	clock     Clock
+}
+
+// NewOverlapUseCase creates a new OverlapUseCase
+func NewOverlapUseCase(resolver TimezoneResolver, formatter OverlapFormatter) *OverlapUseCase {
+	return &OverlapUseCase{
+		resolver:  resolver,
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock makes searches without a start date begin on the clock's day instead of today
+func (uc *OverlapUseCase) WithClock(clock Clock) *OverlapUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// GetOverlap finds and formats the shared working slots for a request
+func (uc *OverlapUseCase) GetOverlap(req OverlapRequest) ([]byte, error) {
+	overlap, err := uc.FindOverlap(req)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatOverlap(overlap)
+}
+
+// FindOverlap resolves every participant and intersects their working hours
+func (uc *OverlapUseCase) FindOverlap(req OverlapRequest) (*Overlap, error) {
+	if len(req.Participants) == 0 {
+		return nil, fmt.Errorf("at least one city or timezone is required")
+	}
+
+	participants := make([]*domain.Participant, 0, len(req.Participants))
+	for _, p := range req.Participants {
+		place := strings.TrimSpace(p.Place)
+		tz, err := uc.resolver.ResolveTimezone(place)
+		if err != nil {
+			return nil, err
+		}
+		hours := domain.DefaultWorkingHours
+		if p.Hours != nil {
+			hours = *p.Hours
+		}
+		name := place
+		if name == tz.String() {
+			name = tz.City()
+		}
+		participants = append(participants, &domain.Participant{Name: name, Timezone: tz, Hours: hours})
+	}
+
+	// The date range follows the calendar of the first participant
+	loc, err := participants[0].Timezone.Location()
+	if err != nil {
+		return nil, err
+	}
+	now := uc.clock.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if req.From != "" {
+		from, err = time.ParseInLocation("2006-01-02", req.From, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %s (expected YYYY-MM-DD)", req.From)
+		}
+	}
+	to := from.AddDate(0, 0, req.Days)
+	if req.To != "" {
+		last, err := time.ParseInLocation("2006-01-02", req.To, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %s (expected YYYY-MM-DD)", req.To)
+		}
+		to = last.AddDate(0, 0, 1)
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("date range must cover at least one day")
+	}
+
+	slots, err := domain.FindOverlaps(participants, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Overlap{
+		Participants: participants,
+		Slots:        slots,
+		From:         from,
+		To:           to,
+	}, nil
+}
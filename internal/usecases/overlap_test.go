@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// MockOverlapFormatter records overlaps for testing
+type MockOverlapFormatter struct {
+	overlap           *Overlap
+	formatErrorCalled bool
+}
+
+func (m *MockOverlapFormatter) FormatOverlap(overlap *Overlap) ([]byte, error) {
+	m.overlap = overlap
+	return []byte("mock overlap"), nil
+}
+
+func (m *MockOverlapFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+func TestOverlapUseCase_ShouldResolvePlacesAndFindSlots(t *testing.T) {
+	// Given an overlap use case
+	formatter := &MockOverlapFormatter{}
+	uc := NewOverlapUseCase(newMockResolver(), formatter)
+
+	// When finding overlaps for London and New York over two days
+	_, err := uc.GetOverlap(OverlapRequest{
+		Participants: []ParticipantRequest{{Place: "London"}, {Place: "NYC"}},
+		From:         "2026-06-10",
+		Days:         2,
+	})
+
+	// Then it should find one slot per day
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	overlap := formatter.overlap
+	if len(overlap.Slots) != 2 {
+		t.Fatalf("expected 2 slots, got %d", len(overlap.Slots))
+	}
+
+	// And the range should start at midnight in the first participant's zone
+	london, _ := time.LoadLocation("Europe/London")
+	if !overlap.From.Equal(time.Date(2026, time.June, 10, 0, 0, 0, 0, london)) {
+		t.Errorf("unexpected range start %v", overlap.From)
+	}
+	if overlap.Participants[1].Timezone.String() != "America/New_York" {
+		t.Errorf("expected NYC to resolve to America/New_York, got %s", overlap.Participants[1].Timezone)
+	}
+}
+
+func TestOverlapUseCase_ShouldHonourPerParticipantHoursAndEndDate(t *testing.T) {
+	// Given an overlap use case
+	uc := NewOverlapUseCase(newMockResolver(), &MockOverlapFormatter{})
+	early, _ := domain.ParseWorkingHours("07:00-15:00")
+
+	// When a participant works early hours and the range is given by end date
+	overlap, err := uc.FindOverlap(OverlapRequest{
+		Participants: []ParticipantRequest{{Place: "London"}, {Place: "NYC", Hours: &early}},
+		From:         "2026-06-10",
+		To:           "2026-06-12",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then every day in the inclusive range should have a longer slot
+	if len(overlap.Slots) != 3 {
+		t.Fatalf("expected 3 slots, got %d", len(overlap.Slots))
+	}
+	if overlap.Slots[0].Duration() != 5*time.Hour {
+		t.Errorf("expected 5h overlap, got %v", overlap.Slots[0].Duration())
+	}
+}
+
+func TestOverlapUseCase_ShouldSkipParticipantHolidays(t *testing.T) {
+	// Given an overlap use case
+	uc := NewOverlapUseCase(newMockResolver(), &MockOverlapFormatter{})
+
+	// When finding overlaps from Easter Monday, a holiday in London but not New York
+	overlap, err := uc.FindOverlap(OverlapRequest{
+		Participants: []ParticipantRequest{{Place: "London"}, {Place: "NYC"}},
+		From:         "2026-04-06",
+		Days:         2,
+	})
+
+	// Then only the Tuesday should have a slot
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(overlap.Slots) != 1 || overlap.Slots[0].Start.Day() != 7 {
+		t.Errorf("expected a single slot on 7 April, got %v", overlap.Slots)
+	}
+}
+
+func TestOverlapUseCase_ShouldRejectInvalidRequests(t *testing.T) {
+	// Given an overlap use case
+	formatter := &MockOverlapFormatter{}
+	uc := NewOverlapUseCase(newMockResolver(), formatter)
+
+	requests := []OverlapRequest{
+		{},
+		{Participants: []ParticipantRequest{{Place: "London"}}, Days: 0},
+		{Participants: []ParticipantRequest{{Place: "London"}}, From: "10/06/2026", Days: 1},
+		{Participants: []ParticipantRequest{{Place: "Atlantis"}}, Days: 1},
+	}
+
+	// Then each invalid request should produce a formatted error
+	for i, req := range requests {
+		if _, err := uc.GetOverlap(req); err == nil {
+			t.Errorf("request %d: expected error", i)
+		}
+	}
+	if !formatter.formatErrorCalled {
+		t.Error("expected errors to be formatted for user display")
+	}
+}
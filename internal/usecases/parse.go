@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// ParsedTimestamp is a detected timestamp shown in UTC, the home zone and any requested zones
+type ParsedTimestamp struct {
+	Input     string
+	Timestamp *domain.Timestamp
+	Zones     []*TimezoneInfo // UTC first, then home (if set), then the requested zones
+}
+
+// ParseUseCase handles detecting machine-readable timestamps and converting them
+type ParseUseCase struct {
+	resolver  TimezoneResolver
+	formatter ParseFormatter
+	home      *domain.Timezone
+}
+
+// NewParseUseCase creates a new ParseUseCase
+func NewParseUseCase(resolver TimezoneResolver, formatter ParseFormatter) *ParseUseCase {
+	return &ParseUseCase{
+		resolver:  resolver,
+		formatter: formatter,
+	}
+}
+
+// WithHomeTimezone makes every parsed timestamp also show in the user's home timezone
+func (uc *ParseUseCase) WithHomeTimezone(home *domain.Timezone) *ParseUseCase {
+	uc.home = home
+	return uc
+}
+
+// Parse detects and formats a timestamp such as "1718035200000" in the given zones
+func (uc *ParseUseCase) Parse(input string, zones []string) ([]byte, error) {
+	parsed, err := uc.ParseTimestamp(input, zones)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatParsedTimestamp(parsed)
+}
+
+// ParseTimestamp detects the timestamp's representation and resolves every zone to show it in
+func (uc *ParseUseCase) ParseTimestamp(input string, zones []string) (*ParsedTimestamp, error) {
+	ts, err := domain.ParseTimestamp(input)
+	if err != nil {
+		return nil, err
+	}
+
+	utc, err := domain.NewTimezone("UTC")
+	if err != nil {
+		return nil, err
+	}
+	targets := []*domain.Timezone{utc}
+	if uc.home != nil {
+		targets = append(targets, uc.home)
+	}
+	for _, query := range zones {
+		tz, err := uc.resolver.ResolveTimezone(query)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, tz)
+	}
+
+	parsed := &ParsedTimestamp{Input: input, Timestamp: ts}
+	// Aliases such as Etc/UTC and UTC would show identical rows, so zones are
+	// keyed by display name and offset rather than by IANA name
+	seen := make(map[string]bool, len(targets))
+	for _, tz := range targets {
+		loc, err := tz.Location()
+		if err != nil {
+			return nil, err
+		}
+		t := ts.Time.In(loc)
+		_, offset := t.Zone()
+		key := fmt.Sprintf("%s %d", tz.City(), offset)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		parsed.Zones = append(parsed.Zones, newTimezoneInfo(tz, t))
+	}
+	return parsed, nil
+}
@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// MockParseFormatter records parsed timestamps for testing
+type MockParseFormatter struct {
+	parsed    *ParsedTimestamp
+	lastError string
+}
+
+func (m *MockParseFormatter) FormatParsedTimestamp(parsed *ParsedTimestamp) ([]byte, error) {
+	m.parsed = parsed
+	return []byte("mock parsed"), nil
+}
+
+func (m *MockParseFormatter) FormatError(message string) ([]byte, error) {
+	m.lastError = message
+	return []byte("mock error"), nil
+}
+
+func TestParseUseCase_ShouldShowTimestampInUTCHomeAndRequestedZones(t *testing.T) {
+	// Given a parse use case with a home timezone
+	home, _ := domain.NewTimezone("America/New_York")
+	formatter := &MockParseFormatter{}
+	uc := NewParseUseCase(newMockResolver(), formatter).WithHomeTimezone(home)
+
+	// When parsing epoch milliseconds for Tokyo
+	_, err := uc.Parse("1718035200000", []string{"Tokyo"})
+
+	// Then the format should be detected
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parsed := formatter.parsed
+	if parsed.Timestamp.Format != "Unix milliseconds" {
+		t.Errorf("expected Unix milliseconds, got %s", parsed.Timestamp.Format)
+	}
+
+	// And the instant should be shown in UTC, home and Tokyo
+	expected := []struct{ zone, clock string }{
+		{"UTC", "16:00"},
+		{"America/New_York", "12:00"},
+		{"Asia/Tokyo", "01:00"},
+	}
+	if len(parsed.Zones) != len(expected) {
+		t.Fatalf("expected %d zones, got %d", len(expected), len(parsed.Zones))
+	}
+	for i, e := range expected {
+		info := parsed.Zones[i]
+		if info.Timezone.String() != e.zone || info.CurrentTime.Format("15:04") != e.clock {
+			t.Errorf("expected %s at %s, got %s at %s", e.zone, e.clock, info.Timezone, info.CurrentTime.Format("15:04"))
+		}
+	}
+}
+
+func TestParseUseCase_ShouldNotRepeatZones(t *testing.T) {
+	// Given a parse use case whose home is London
+	home, _ := domain.NewTimezone("Europe/London")
+	uc := NewParseUseCase(newMockResolver(), &MockParseFormatter{}).WithHomeTimezone(home)
+
+	// When London is also requested
+	parsed, err := uc.ParseTimestamp("2024-06-10T16:00:00Z", []string{"London"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then it should only be listed once
+	if len(parsed.Zones) != 2 {
+		t.Errorf("expected UTC and London only, got %d zones", len(parsed.Zones))
+	}
+}
+
+func TestParseUseCase_ShouldReportUnrecognisedTimestamps(t *testing.T) {
+	// Given a parse use case
+	formatter := &MockParseFormatter{}
+	uc := NewParseUseCase(newMockResolver(), formatter)
+
+	// When parsing something that is not a timestamp
+	output, err := uc.Parse("not a time", nil)
+
+	// Then an error should be formatted
+	if err == nil {
+		t.Fatal("expected error for unrecognised timestamp")
+	}
+	if string(output) != "mock error" || formatter.lastError == "" {
+		t.Errorf("expected formatted error, got '%s'", output)
+	}
+}
+
+func TestParseUseCase_ShouldNameZonesAsTheyWereAtTheTimestamp(t *testing.T) {
+	// Given a parse use case
+	uc := NewParseUseCase(newMockResolver(), &MockParseFormatter{})
+
+	// When parsing the Unix epoch for London, which kept British Standard Time all year until 1971
+	parsed, err := uc.ParseTimestamp("0", []string{"London"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then London should read 01:00 BST rather than today's winter name
+	if len(parsed.Zones) != 2 {
+		t.Fatalf("expected UTC and London, got %d zones", len(parsed.Zones))
+	}
+	london := parsed.Zones[1]
+	if london.Abbreviation != "BST" || london.CurrentTime.Format("15:04") != "01:00" {
+		t.Errorf("expected London at 01:00 BST, got %s %s", london.CurrentTime.Format("15:04"), london.Abbreviation)
+	}
+}
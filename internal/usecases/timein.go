@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"errors"
 	"time"
 
 	"github.com/loginx/alfred-timein/internal/domain"
@@ -10,24 +11,63 @@ import (
 // TimeinUseCase handles timezone information retrieval
 type TimeinUseCase struct {
 	formatter OutputFormatter
+	clock     Clock
 }
 
 // NewTimeinUseCase creates a new TimeinUseCase
 func NewTimeinUseCase(formatter OutputFormatter) *TimeinUseCase {
 	return &TimeinUseCase{
 		formatter: formatter,
+		clock:     SystemClock{},
 	}
 }
 
-// GetTimezoneInfo gets current time information for a timezone
+// WithClock makes the use case report times at the clock's instant instead of now
+func (uc *TimeinUseCase) WithClock(clock Clock) *TimeinUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// SuggestedZonesError is returned with output listing suggested zones when the zone
+// asked for could not be resolved, so callers can show the suggestions and still fail
+type SuggestedZonesError struct {
+	Err error
+}
+
+func (e *SuggestedZonesError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SuggestedZonesError) Unwrap() error {
+	return e.Err
+}
+
+// GetTimezoneInfo gets time information for a timezone at the clock's instant. Partial or
+// misspelled names are answered with suggested zones and a *SuggestedZonesError
 func (uc *TimeinUseCase) GetTimezoneInfo(timezoneStr string) ([]byte, error) {
-	tz, err := domain.NewTimezone(timezoneStr)
+	tz, err := domain.NewPlacedTimezone(timezoneStr)
 	if err != nil {
+		// Offer each zone an ambiguous abbreviation may mean when the formatter can list them
+		var ambiguous *domain.AmbiguousAbbreviationError
+		if wc, ok := uc.formatter.(WorldClockFormatter); ok && errors.As(err, &ambiguous) {
+			return wc.FormatWorldClock(candidateInfos(ambiguous.Candidates, uc.clock.Now()))
+		}
+		// Suggest zones for partial or misspelled names when the formatter can list them
+		if zs, ok := uc.formatter.(ZoneSearchFormatter); ok {
+			name, _ := domain.SplitCoordinates(timezoneStr)
+			if search, searchErr := NewZoneSearchUseCase(zs).WithClock(uc.clock).FindZones(name); searchErr == nil {
+				output, formatErr := zs.FormatZoneSearch(search)
+				if formatErr != nil {
+					return nil, formatErr
+				}
+				return output, &SuggestedZonesError{Err: err}
+			}
+		}
 		output, _ := uc.formatter.FormatError(err.Error())
 		return output, err
 	}
 
-	return uc.formatter.FormatTimeInfo(tz)
+	return uc.formatter.FormatTimeInfo(tz, uc.clock.Now())
 }
 
 // TimezoneInfo represents timezone information for formatting
@@ -40,7 +80,7 @@ type TimezoneInfo struct {
 
 // GetTimezoneInfoForFormatting gets timezone info structured for formatting
 func (uc *TimeinUseCase) GetTimezoneInfoForFormatting(timezoneStr string) (*TimezoneInfo, error) {
-	tz, err := domain.NewTimezone(timezoneStr)
+	tz, err := domain.NewPlacedTimezone(timezoneStr)
 	if err != nil {
 		return nil, err
 	}
@@ -50,21 +90,59 @@ func (uc *TimeinUseCase) GetTimezoneInfoForFormatting(timezoneStr string) (*Time
 		return nil, err
 	}
 
-	now := time.Now().In(loc)
-	city := tz.City()
+	return newTimezoneInfo(tz, uc.clock.Now().In(loc)), nil
+}
 
-	// Get timezone abbreviation
+// newTimezoneInfo builds the display information for tz at instant t
+func newTimezoneInfo(tz *domain.Timezone, t time.Time) *TimezoneInfo {
+	return &TimezoneInfo{
+		Timezone:     tz,
+		CurrentTime:  t,
+		City:         tz.City(),
+		Abbreviation: Abbreviation(tz, t),
+	}
+}
+
+// candidateInfos builds the display information for every candidate zone at instant t
+func candidateInfos(candidates []*domain.Timezone, t time.Time) []*TimezoneInfo {
+	infos := make([]*TimezoneInfo, 0, len(candidates))
+	for _, tz := range candidates {
+		loc, err := tz.Location()
+		if err != nil {
+			continue
+		}
+		infos = append(infos, newTimezoneInfo(tz, t.In(loc)))
+	}
+	return infos
+}
+
+// Abbreviation returns the abbreviation in effect in tz at t. tzdb's own name for the
+// instant is used whenever it has one, such as BST for London in 1970; numeric names such
+// as "+04" give way to a commonly used abbreviation where one is known
+func Abbreviation(tz *domain.Timezone, t time.Time) string {
+	name, _ := t.Zone()
+	if !isNumericZoneName(name) {
+		return name
+	}
 	tzlib := timezone.New()
-	isDST := tzlib.IsDST(now)
-	abbr, err := tzlib.GetTimezoneAbbreviation(tz.String(), isDST)
-	if err != nil || abbr == "" {
-		abbr = now.Format("MST")
+	abbr, err := tzlib.GetTimezoneAbbreviation(tz.String(), tzlib.IsDST(t))
+	if err != nil || !isLetters(abbr) {
+		return name
 	}
+	return abbr
+}
 
-	return &TimezoneInfo{
-		Timezone:     tz,
-		CurrentTime:  now,
-		City:         city,
-		Abbreviation: abbr,
-	}, nil
-}
\ No newline at end of file
+// isNumericZoneName reports whether tzdb names a period by its offset, e.g. "+04" or "-0330"
+func isNumericZoneName(name string) bool {
+	return name == "" || name[0] == '+' || name[0] == '-'
+}
+
+// isLetters reports whether s is a non-empty run of ASCII letters
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return s != ""
+}
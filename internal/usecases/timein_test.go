@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"testing"
+	"time"
 
 	"github.com/loginx/alfred-timein/internal/domain"
 )
@@ -11,14 +12,16 @@ type MockFormatter struct {
 	formatTimeInfoCalled bool
 	formatErrorCalled    bool
 	lastError           string
+	lastInstant          time.Time
 }
 
 func (m *MockFormatter) FormatTimezoneInfo(timezone *domain.Timezone, city string, cached bool) ([]byte, error) {
 	return []byte("mock timezone info"), nil
 }
 
-func (m *MockFormatter) FormatTimeInfo(timezone *domain.Timezone) ([]byte, error) {
+func (m *MockFormatter) FormatTimeInfo(timezone *domain.Timezone, at time.Time) ([]byte, error) {
 	m.formatTimeInfoCalled = true
+	m.lastInstant = at
 	return []byte("mock time info"), nil
 }
 
@@ -108,4 +111,77 @@ func TestTimeinUseCase_ShouldProvideCompleteTimezoneInformation(t *testing.T) {
 	if info.Abbreviation == "" {
 		t.Error("Expected timezone abbreviation to be provided")
 	}
-}
\ No newline at end of file
+}
+
+// MockCandidateFormatter also lists zones, as the presenters do
+type MockCandidateFormatter struct {
+	MockFormatter
+	infos []*TimezoneInfo
+}
+
+func (m *MockCandidateFormatter) FormatWorldClock(infos []*TimezoneInfo) ([]byte, error) {
+	m.infos = infos
+	return []byte("mock candidates"), nil
+}
+
+func TestTimeinUseCase_ShouldListCandidatesForAmbiguousAbbreviation(t *testing.T) {
+	// Given a formatter that can list several zones
+	formatter := &MockCandidateFormatter{}
+	uc := NewTimeinUseCase(formatter)
+
+	// When asking for an ambiguous abbreviation
+	output, err := uc.GetTimezoneInfo("CST")
+
+	// Then every candidate zone should be listed instead of an error
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(output) != "mock candidates" || formatter.formatErrorCalled {
+		t.Errorf("expected candidate output, got '%s'", string(output))
+	}
+	if len(formatter.infos) != 3 || formatter.infos[1].Timezone.String() != "Asia/Shanghai" {
+		t.Errorf("unexpected candidates %v", formatter.infos)
+	}
+}
+
+func TestTimeinUseCase_ShouldReportAmbiguityWithoutCandidateFormatter(t *testing.T) {
+	// Given a formatter that can only show one zone
+	formatter := &MockFormatter{}
+	uc := NewTimeinUseCase(formatter)
+
+	// When asking for an ambiguous abbreviation
+	_, err := uc.GetTimezoneInfo("IST")
+
+	// Then the ambiguity should be reported as an error
+	if err == nil || !formatter.formatErrorCalled {
+		t.Error("expected an ambiguity error")
+	}
+}
+
+func TestAbbreviation_ShouldUseTheNameInEffectAtTheInstant(t *testing.T) {
+	// Given zones at instants whose abbreviation differs from today's
+	tests := []struct {
+		zone     string
+		at       string
+		expected string
+	}{
+		{"Europe/London", "1970-01-01T00:00:00Z", "BST"},
+		{"Europe/London", "2026-01-15T12:00:00Z", "GMT"},
+		{"Europe/Saratov", "2026-01-15T12:00:00Z", "+04"},
+		{"America/New_York", "2026-07-15T12:00:00Z", "EDT"},
+	}
+
+	for _, tt := range tests {
+		tz, _ := domain.NewTimezone(tt.zone)
+		loc, _ := tz.Location()
+		at, _ := time.Parse(time.RFC3339, tt.at)
+
+		// When looking up the abbreviation for that instant
+		abbr := Abbreviation(tz, at.In(loc))
+
+		// Then it should be the one tzdb gives for the instant
+		if abbr != tt.expected {
+			t.Errorf("Abbreviation(%s, %s) = %q, expected %q", tt.zone, tt.at, abbr, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// TransitionReport lists UTC-offset transitions of a timezone
+type TransitionReport struct {
+	Timezone    *domain.Timezone
+	Transitions []domain.Transition
+	Year        int // 0 when reporting the previous and next transition around Now
+	Now         time.Time
+}
+
+// TransitionsUseCase handles reporting DST and other offset transitions
+type TransitionsUseCase struct {
+	resolver  TimezoneResolver
+	formatter TransitionFormatter
+	clock     Clock
+}
+
+// NewTransitionsUseCase creates a new TransitionsUseCase
+func NewTransitionsUseCase(resolver TimezoneResolver, formatter TransitionFormatter) *TransitionsUseCase {
+	return &TransitionsUseCase{
+		resolver:  resolver,
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock makes the previous and next transitions surround the clock's instant instead of now
+func (uc *TransitionsUseCase) WithClock(clock Clock) *TransitionsUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// GetTransitions formats the transitions for a zone; year 0 means previous and next around now
+func (uc *TransitionsUseCase) GetTransitions(query string, year int) ([]byte, error) {
+	report, err := uc.FindTransitions(query, year)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatTransitions(report)
+}
+
+// FindTransitions resolves the zone and collects its transitions
+func (uc *TransitionsUseCase) FindTransitions(query string, year int) (*TransitionReport, error) {
+	tz, err := uc.resolver.ResolveTimezone(query)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := tz.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &TransitionReport{Timezone: tz, Year: year, Now: uc.clock.Now().In(loc)}
+
+	if year != 0 {
+		if year < 1 || year > 9999 {
+			return nil, fmt.Errorf("invalid year: %d", year)
+		}
+		from := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		report.Transitions, err = tz.Transitions(from, from.AddDate(1, 0, 0))
+		if err != nil {
+			return nil, err
+		}
+		return report, nil
+	}
+
+	prev, err := tz.PreviousTransition(report.Now)
+	if err != nil {
+		return nil, err
+	}
+	next, err := tz.NextTransition(report.Now)
+	if err != nil {
+		return nil, err
+	}
+	for _, tr := range []*domain.Transition{prev, next} {
+		if tr != nil {
+			report.Transitions = append(report.Transitions, *tr)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"testing"
+)
+
+// MockTransitionFormatter records transition reports for testing
+type MockTransitionFormatter struct {
+	report            *TransitionReport
+	formatErrorCalled bool
+}
+
+func (m *MockTransitionFormatter) FormatTransitions(report *TransitionReport) ([]byte, error) {
+	m.report = report
+	return []byte("mock transitions"), nil
+}
+
+func (m *MockTransitionFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+func TestTransitionsUseCase_ShouldListAYearOfTransitions(t *testing.T) {
+	// Given a transitions use case
+	formatter := &MockTransitionFormatter{}
+	uc := NewTransitionsUseCase(newMockResolver(), formatter)
+
+	// When listing 2026 for New York
+	_, err := uc.GetTransitions("NYC", 2026)
+
+	// Then both DST changes should be reported in order
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	trs := formatter.report.Transitions
+	if len(trs) != 2 {
+		t.Fatalf("expected 2 transitions, got %d", len(trs))
+	}
+	if !trs[0].StartsDST() || !trs[1].EndsDST() {
+		t.Errorf("expected DST start then end, got %+v", trs)
+	}
+}
+
+func TestTransitionsUseCase_ShouldReportPreviousAndNextAroundNow(t *testing.T) {
+	// Given a transitions use case
+	uc := NewTransitionsUseCase(newMockResolver(), &MockTransitionFormatter{})
+
+	// When no year is given
+	report, err := uc.FindTransitions("London", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then the previous and next transition should surround now
+	if len(report.Transitions) != 2 {
+		t.Fatalf("expected 2 transitions, got %d", len(report.Transitions))
+	}
+	if report.Transitions[0].At.After(report.Now) || !report.Transitions[1].At.After(report.Now) {
+		t.Errorf("expected transitions around %v, got %v and %v", report.Now, report.Transitions[0].At, report.Transitions[1].At)
+	}
+}
+
+func TestTransitionsUseCase_ShouldRejectUnknownZones(t *testing.T) {
+	// Given a transitions use case
+	formatter := &MockTransitionFormatter{}
+	uc := NewTransitionsUseCase(newMockResolver(), formatter)
+
+	// When the zone cannot be resolved
+	if _, err := uc.GetTransitions("Atlantis", 0); err == nil {
+		t.Fatal("expected error for unknown zone")
+	}
+
+	// Then the error should be formatted
+	if !formatter.formatErrorCalled {
+		t.Error("expected error to be formatted for user display")
+	}
+}
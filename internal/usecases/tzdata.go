@@ -0,0 +1,188 @@
+package usecases
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// tzdataDiffYears is how far past now FindDiff compares rules; before 1970 tzdb
+// releases are allowed to disagree, so comparisons start there
+const tzdataDiffYears = 10
+
+// VersionReport lists the data releases a binary answers with
+type VersionReport struct {
+	Build    string // the binary's module version
+	Mode     string // where zone rules come from: auto, host or embedded
+	Host     string // host tzdata release, "" when unknown
+	Embedded string // tzdata release built into the binary
+	Tzf      string // tzf boundary dataset release, "" when unavailable
+}
+
+// ZoneRulesComparison is the outcome of comparing one zone across two tzdata copies
+type ZoneRulesComparison struct {
+	Zone       string
+	Difference *domain.RulesDifference // nil when both copies agree
+	Missing    string                  // the name of a source lacking the zone, if any
+}
+
+// Differs reports whether the two copies disagree on the zone or one lacks it
+func (c *ZoneRulesComparison) Differs() bool {
+	return c.Difference != nil || c.Missing != ""
+}
+
+// TzdataDiff compares the host's and the embedded zone rules for a set of zones
+type TzdataDiff struct {
+	Left         string
+	LeftVersion  string
+	Right        string
+	RightVersion string
+	From         time.Time
+	To           time.Time
+	Zones        []ZoneRulesComparison
+}
+
+// Differing returns how many zones disagree or are missing from one side
+func (d *TzdataDiff) Differing() int {
+	n := 0
+	for i := range d.Zones {
+		if d.Zones[i].Differs() {
+			n++
+		}
+	}
+	return n
+}
+
+// TzdataUseCase reports tzdata releases and compares host and embedded zone rules
+type TzdataUseCase struct {
+	host      TzdataSource
+	embedded  TzdataSource
+	formatter TzdataFormatter
+	cache     CacheLister
+	dataset   DatasetVersioner
+	clock     Clock
+}
+
+// NewTzdataUseCase creates a new TzdataUseCase
+func NewTzdataUseCase(host, embedded TzdataSource, formatter TzdataFormatter) *TzdataUseCase {
+	return &TzdataUseCase{
+		host:      host,
+		embedded:  embedded,
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithCache compares the zones the user has looked up when none are named
+func (uc *TzdataUseCase) WithCache(cache CacheLister) *TzdataUseCase {
+	uc.cache = cache
+	return uc
+}
+
+// WithDataset reports the release of the geolocation dataset alongside tzdata
+func (uc *TzdataUseCase) WithDataset(dataset DatasetVersioner) *TzdataUseCase {
+	uc.dataset = dataset
+	return uc
+}
+
+// WithClock makes the compared range end relative to the clock's instant
+func (uc *TzdataUseCase) WithClock(clock Clock) *TzdataUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// GetVersion formats the version report
+func (uc *TzdataUseCase) GetVersion(build, mode string) ([]byte, error) {
+	return uc.formatter.FormatVersion(uc.FindVersion(build, mode))
+}
+
+// FindVersion gathers the tzdata and dataset releases in use
+func (uc *TzdataUseCase) FindVersion(build, mode string) *VersionReport {
+	report := &VersionReport{
+		Build:    build,
+		Mode:     mode,
+		Host:     uc.host.Version(),
+		Embedded: uc.embedded.Version(),
+	}
+	if uc.dataset != nil {
+		if version, err := uc.dataset.DataVersion(); err == nil {
+			report.Tzf = version
+		}
+	}
+	return report
+}
+
+// GetDiff formats the comparison of host and embedded rules
+func (uc *TzdataUseCase) GetDiff(zones []string) ([]byte, error) {
+	diff, err := uc.FindDiff(zones)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatTzdataDiff(diff)
+}
+
+// FindDiff compares host and embedded rules for the named zones, or for every zone in
+// the cache when none are named, from 1970 until ten years from now
+func (uc *TzdataUseCase) FindDiff(zones []string) (*TzdataDiff, error) {
+	if len(zones) == 0 && uc.cache != nil {
+		for _, value := range uc.cache.Values() {
+			zones = append(zones, CachedZones(value)...)
+		}
+	}
+	names := distinctZoneNames(zones)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no zones to compare: name some or look a few places up first")
+	}
+
+	diff := &TzdataDiff{
+		Left:         uc.host.Name(),
+		LeftVersion:  uc.host.Version(),
+		Right:        uc.embedded.Name(),
+		RightVersion: uc.embedded.Version(),
+		From:         time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:           uc.clock.Now().UTC().AddDate(tzdataDiffYears, 0, 0),
+	}
+	for _, name := range names {
+		comparison := ZoneRulesComparison{Zone: name}
+		left, leftErr := uc.host.LoadLocation(name)
+		right, rightErr := uc.embedded.LoadLocation(name)
+		switch {
+		case leftErr != nil && rightErr != nil:
+			return nil, fmt.Errorf("invalid timezone: %s", name)
+		case leftErr != nil:
+			comparison.Missing = diff.Left
+		case rightErr != nil:
+			comparison.Missing = diff.Right
+		default:
+			comparison.Difference = domain.DiffZoneRules(left, right, diff.From, diff.To)
+		}
+		diff.Zones = append(diff.Zones, comparison)
+	}
+	return diff, nil
+}
+
+// distinctZoneNames reduces zone names, possibly followed by coordinates as the cache
+// stores them, to sorted unique IANA names, leaving out fixed offsets
+func distinctZoneNames(zones []string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, zone := range zones {
+		name, _ := domain.SplitCoordinates(zone)
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		if _, ok := domain.ParseUTCOffset(name); ok {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// MockTzdataSource serves zone rules from a fixed set of locations
+type MockTzdataSource struct {
+	name    string
+	version string
+	zones   map[string]*time.Location
+}
+
+func (m *MockTzdataSource) Name() string    { return m.name }
+func (m *MockTzdataSource) Version() string { return m.version }
+
+func (m *MockTzdataSource) LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := m.zones[name]; ok {
+		return loc, nil
+	}
+	return nil, fmt.Errorf("unknown time zone %s", name)
+}
+
+// MockTzdataFormatter records version reports and comparisons for testing
+type MockTzdataFormatter struct {
+	report            *VersionReport
+	diff              *TzdataDiff
+	formatErrorCalled bool
+}
+
+func (m *MockTzdataFormatter) FormatVersion(report *VersionReport) ([]byte, error) {
+	m.report = report
+	return []byte("mock version"), nil
+}
+
+func (m *MockTzdataFormatter) FormatTzdataDiff(diff *TzdataDiff) ([]byte, error) {
+	m.diff = diff
+	return []byte("mock diff"), nil
+}
+
+func (m *MockTzdataFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+// MockCacheLister lists fixed cache values
+type MockCacheLister []string
+
+func (m MockCacheLister) Values() []string { return m }
+
+// MockDatasetVersioner reports a fixed dataset release
+type MockDatasetVersioner string
+
+func (m MockDatasetVersioner) DataVersion() (string, error) { return string(m), nil }
+
+func newMockTzdataSources() (*MockTzdataSource, *MockTzdataSource) {
+	london, _ := time.LoadLocation("Europe/London")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	host := &MockTzdataSource{name: "host", version: "2025b", zones: map[string]*time.Location{
+		"Europe/London": london,
+		"Asia/Tokyo":    tokyo,
+	}}
+	embedded := &MockTzdataSource{name: "embedded", version: "2026c", zones: map[string]*time.Location{
+		"Europe/London":    time.FixedZone("GMT", 0),
+		"Asia/Tokyo":       tokyo,
+		"America/New_York": tokyo,
+	}}
+	return host, embedded
+}
+
+func TestTzdataUseCase_ShouldReportVersions(t *testing.T) {
+	// Given a tzdata use case with a dataset
+	host, embedded := newMockTzdataSources()
+	formatter := &MockTzdataFormatter{}
+	uc := NewTzdataUseCase(host, embedded, formatter).WithDataset(MockDatasetVersioner("2025b"))
+
+	// When reporting versions
+	if _, err := uc.GetVersion("v1.2.0", "auto"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then every release should be listed
+	expected := VersionReport{Build: "v1.2.0", Mode: "auto", Host: "2025b", Embedded: "2026c", Tzf: "2025b"}
+	if *formatter.report != expected {
+		t.Errorf("expected %+v, got %+v", expected, *formatter.report)
+	}
+}
+
+func TestTzdataUseCase_ShouldCompareCachedZones(t *testing.T) {
+	// Given a cache holding London twice, once with coordinates, plus Tokyo, New York and an offset
+	host, embedded := newMockTzdataSources()
+	cache := MockCacheLister{"Europe/London 51.507400,-0.127800", "Asia/Tokyo", "Europe/London", "America/New_York", "UTC+05:30"}
+	uc := NewTzdataUseCase(host, embedded, &MockTzdataFormatter{}).WithCache(cache)
+
+	// When comparing without naming zones
+	diff, err := uc.FindDiff(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then each zone should be compared once, in name order
+	if len(diff.Zones) != 3 {
+		t.Fatalf("expected 3 zones, got %+v", diff.Zones)
+	}
+	newYork, tokyo, london := diff.Zones[0], diff.Zones[1], diff.Zones[2]
+	if newYork.Zone != "America/New_York" || newYork.Missing != "host" {
+		t.Errorf("expected New York missing from host, got %+v", newYork)
+	}
+	if tokyo.Zone != "Asia/Tokyo" || tokyo.Differs() {
+		t.Errorf("expected Tokyo to agree, got %+v", tokyo)
+	}
+	if london.Zone != "Europe/London" || london.Difference == nil {
+		t.Errorf("expected London to differ, got %+v", london)
+	}
+	if diff.Differing() != 2 {
+		t.Errorf("expected 2 differing zones, got %d", diff.Differing())
+	}
+}
+
+func TestTzdataUseCase_ShouldRejectEmptyComparisons(t *testing.T) {
+	// Given an empty cache
+	host, embedded := newMockTzdataSources()
+	formatter := &MockTzdataFormatter{}
+	uc := NewTzdataUseCase(host, embedded, formatter).WithCache(MockCacheLister{})
+
+	// When comparing without naming zones
+	_, err := uc.GetDiff(nil)
+
+	// Then an error should be formatted
+	if err == nil || !formatter.formatErrorCalled {
+		t.Errorf("expected formatted error, got %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// WorldClockUseCase handles showing the time in several timezones at once
+type WorldClockUseCase struct {
+	formatter WorldClockFormatter
+	clock     Clock
+}
+
+// NewWorldClockUseCase creates a new WorldClockUseCase
+func NewWorldClockUseCase(formatter WorldClockFormatter) *WorldClockUseCase {
+	return &WorldClockUseCase{
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock makes the world clock show the clock's instant instead of now
+func (uc *WorldClockUseCase) WithClock(clock Clock) *WorldClockUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// GetWorldClock formats the clock's instant in every given timezone
+func (uc *WorldClockUseCase) GetWorldClock(timezones []string) ([]byte, error) {
+	infos, err := uc.GetWorldClockInfo(timezones)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatWorldClock(infos)
+}
+
+// GetWorldClockInfo evaluates every timezone against the same captured instant,
+// so the rows of a world clock are consistent with each other; ambiguous
+// abbreviations contribute a row per candidate zone. Zones piped from geotz --coords
+// with a place name are shown under that name rather than the zone's exemplar city,
+// so Portland, Oregon and Portland, Maine are told apart
+func (uc *WorldClockUseCase) GetWorldClockInfo(timezones []string) ([]*TimezoneInfo, error) {
+	names := make([]string, 0, len(timezones))
+	for _, name := range timezones {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("at least one timezone is required")
+	}
+
+	now := uc.clock.Now()
+	infos := make([]*TimezoneInfo, 0, len(names))
+	for _, name := range names {
+		tz, err := domain.NewPlacedTimezone(name)
+		var ambiguous *domain.AmbiguousAbbreviationError
+		if errors.As(err, &ambiguous) {
+			infos = append(infos, candidateInfos(ambiguous.Candidates, now)...)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		loc, err := tz.Location()
+		if err != nil {
+			return nil, err
+		}
+		info := newTimezoneInfo(tz, now.In(loc))
+		if tz.Place != nil && tz.Place.DisplayName != "" {
+			info.City = tz.Place.DisplayName
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
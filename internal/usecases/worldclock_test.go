@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"testing"
+)
+
+// MockWorldClockFormatter records world clock rows for testing
+type MockWorldClockFormatter struct {
+	infos             []*TimezoneInfo
+	formatErrorCalled bool
+}
+
+func (m *MockWorldClockFormatter) FormatWorldClock(infos []*TimezoneInfo) ([]byte, error) {
+	m.infos = infos
+	return []byte("mock world clock"), nil
+}
+
+func (m *MockWorldClockFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+func TestWorldClockUseCase_ShouldEvaluateAllZonesAtTheSameInstant(t *testing.T) {
+	// Given a world clock use case
+	formatter := &MockWorldClockFormatter{}
+	uc := NewWorldClockUseCase(formatter)
+
+	// When requesting several zones
+	output, err := uc.GetWorldClock([]string{"Asia/Tokyo", "Europe/London", "America/New_York"})
+
+	// Then it should format one row per zone in order
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(output) != "mock world clock" {
+		t.Errorf("expected world clock output, got '%s'", string(output))
+	}
+	if len(formatter.infos) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(formatter.infos))
+	}
+	if formatter.infos[1].Timezone.String() != "Europe/London" {
+		t.Errorf("expected rows in input order, got %s second", formatter.infos[1].Timezone)
+	}
+
+	// And every row should describe the same instant
+	for _, info := range formatter.infos[1:] {
+		if !info.CurrentTime.Equal(formatter.infos[0].CurrentTime) {
+			t.Errorf("expected identical instants, got %v and %v", formatter.infos[0].CurrentTime, info.CurrentTime)
+		}
+	}
+}
+
+func TestWorldClockUseCase_ShouldRejectInvalidZones(t *testing.T) {
+	// Given a world clock use case
+	formatter := &MockWorldClockFormatter{}
+	uc := NewWorldClockUseCase(formatter)
+
+	// When one of the zones is invalid
+	_, err := uc.GetWorldClock([]string{"Asia/Tokyo", "Invalid/Timezone"})
+
+	// Then it should report an error
+	if err == nil {
+		t.Fatal("expected error for invalid timezone")
+	}
+	if !formatter.formatErrorCalled {
+		t.Error("expected error to be formatted for user display")
+	}
+}
+
+func TestWorldClockUseCase_ShouldIgnoreBlankEntries(t *testing.T) {
+	// Given a world clock use case
+	uc := NewWorldClockUseCase(&MockWorldClockFormatter{})
+
+	// When blank lines are mixed in
+	infos, err := uc.GetWorldClockInfo([]string{"", "UTC", "   "})
+
+	// Then they should be skipped
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(infos) != 1 {
+		t.Errorf("expected 1 row, got %d", len(infos))
+	}
+
+	// And nothing at all should be an error
+	if _, err := uc.GetWorldClockInfo([]string{" "}); err == nil {
+		t.Error("expected error for empty zone list")
+	}
+}
+
+func TestWorldClockUseCase_ShouldExpandAmbiguousAbbreviations(t *testing.T) {
+	// Given a world clock use case
+	formatter := &MockWorldClockFormatter{}
+	uc := NewWorldClockUseCase(formatter)
+
+	// When one of the zones is an ambiguous abbreviation
+	_, err := uc.GetWorldClock([]string{"PST", "IST"})
+
+	// Then it should contribute a row per candidate
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(formatter.infos) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(formatter.infos))
+	}
+	if formatter.infos[0].Timezone.String() != "America/Los_Angeles" || formatter.infos[3].Timezone.String() != "Asia/Jerusalem" {
+		t.Errorf("unexpected rows %s ... %s", formatter.infos[0].Timezone, formatter.infos[3].Timezone)
+	}
+}
+
+func TestWorldClockUseCase_ShouldNamePipedPlaces(t *testing.T) {
+	// Given the candidates geotz --coords pipes for an ambiguous name
+	formatter := &MockWorldClockFormatter{}
+	uc := NewWorldClockUseCase(formatter)
+
+	// When showing them
+	_, err := uc.GetWorldClock([]string{
+		"America/Los_Angeles 45.523450,-122.676210 Portland, Oregon, United States",
+		"America/New_York 43.661470,-70.255330 Portland, Maine, United States",
+		"Asia/Tokyo",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then each place should be named, and zones without one by their exemplar city
+	expected := []string{"Portland, Oregon, United States", "Portland, Maine, United States", "Tokyo"}
+	for i, city := range expected {
+		if formatter.infos[i].City != city {
+			t.Errorf("row %d: expected %q, got %q", i, city, formatter.infos[i].City)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// ZoneListRequest filters the zones listed by offset
+type ZoneListRequest struct {
+	Offset string // e.g. "+05:30" or "UTC-3"; empty for every offset
+	Region string // zone name prefix, e.g. "Europe/", matched ignoring case
+	DST    *bool  // when set, only zones that do or do not observe DST in the year
+}
+
+// ZoneListing is one zone in a ZoneList
+type ZoneListing struct {
+	*TimezoneInfo
+	ObservesDST bool // on daylight saving time at some point in the year
+}
+
+// ZoneOffsetGroup lists the zones sharing a UTC offset
+type ZoneOffsetGroup struct {
+	Offset int // seconds east of UTC
+	Zones  []*ZoneListing
+}
+
+// ZoneList groups zones by their UTC offset at an instant, west to east
+type ZoneList struct {
+	At     time.Time
+	Groups []*ZoneOffsetGroup
+}
+
+// Count returns how many zones are listed across every group
+func (l *ZoneList) Count() int {
+	n := 0
+	for _, g := range l.Groups {
+		n += len(g.Zones)
+	}
+	return n
+}
+
+// ZoneListUseCase handles listing IANA zones grouped by UTC offset
+type ZoneListUseCase struct {
+	formatter ZoneListFormatter
+	clock     Clock
+}
+
+// NewZoneListUseCase creates a new ZoneListUseCase
+func NewZoneListUseCase(formatter ZoneListFormatter) *ZoneListUseCase {
+	return &ZoneListUseCase{
+		formatter: formatter,
+		clock:     SystemClock{},
+	}
+}
+
+// WithClock groups zones by their offsets at the clock's instant instead of now
+func (uc *ZoneListUseCase) WithClock(clock Clock) *ZoneListUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// GetZoneList formats the zones matching a request
+func (uc *ZoneListUseCase) GetZoneList(req ZoneListRequest) ([]byte, error) {
+	list, err := uc.FindZoneList(req)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatZoneList(list)
+}
+
+// FindZoneList loads every zone, keeps those matching the request and groups them by offset
+func (uc *ZoneListUseCase) FindZoneList(req ZoneListRequest) (*ZoneList, error) {
+	wantOffset, filterOffset := 0, strings.TrimSpace(req.Offset) != ""
+	if filterOffset {
+		var ok bool
+		if wantOffset, ok = domain.ParseUTCOffset(req.Offset); !ok {
+			return nil, fmt.Errorf("invalid UTC offset: %s", strings.TrimSpace(req.Offset))
+		}
+	}
+	region := strings.ToLower(strings.TrimSpace(req.Region))
+
+	at := uc.clock.Now()
+	list := &ZoneList{At: at}
+	groups := make(map[int]*ZoneOffsetGroup)
+	for _, name := range domain.ZoneNames() {
+		if region != "" && !strings.HasPrefix(strings.ToLower(name), region) {
+			continue
+		}
+		tz := &domain.Timezone{Name: name}
+		loc, err := tz.Location()
+		if err != nil {
+			continue
+		}
+		local := at.In(loc)
+		_, offset := local.Zone()
+		if filterOffset && offset != wantOffset {
+			continue
+		}
+		observesDST, err := tz.ObservesDST(at)
+		if err != nil {
+			return nil, err
+		}
+		if req.DST != nil && observesDST != *req.DST {
+			continue
+		}
+
+		group, ok := groups[offset]
+		if !ok {
+			group = &ZoneOffsetGroup{Offset: offset}
+			groups[offset] = group
+			list.Groups = append(list.Groups, group)
+		}
+		group.Zones = append(group.Zones, &ZoneListing{
+			TimezoneInfo: newTimezoneInfo(tz, local),
+			ObservesDST:  observesDST,
+		})
+	}
+
+	sort.Slice(list.Groups, func(i, j int) bool {
+		return list.Groups[i].Offset < list.Groups[j].Offset
+	})
+	return list, nil
+}
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+)
+
+// MockZoneListFormatter records zone lists for testing
+type MockZoneListFormatter struct {
+	list              *ZoneList
+	formatErrorCalled bool
+}
+
+func (m *MockZoneListFormatter) FormatZoneList(list *ZoneList) ([]byte, error) {
+	m.list = list
+	return []byte("mock zone list"), nil
+}
+
+func (m *MockZoneListFormatter) FormatError(message string) ([]byte, error) {
+	m.formatErrorCalled = true
+	return []byte("mock error"), nil
+}
+
+func TestZoneListUseCase_ShouldGroupZonesByOffsetWestToEast(t *testing.T) {
+	// Given a zone list use case
+	formatter := &MockZoneListFormatter{}
+	uc := NewZoneListUseCase(formatter).WithClock(FixedClock(time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)))
+
+	// When listing every zone
+	_, err := uc.GetZoneList(ZoneListRequest{})
+
+	// Then the groups should run from the westernmost offset to the easternmost
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	groups := formatter.list.Groups
+	if len(groups) < 20 || formatter.list.Count() < 300 {
+		t.Fatalf("expected every zone in many groups, got %d zones in %d groups", formatter.list.Count(), len(groups))
+	}
+	for i := 1; i < len(groups); i++ {
+		if groups[i-1].Offset >= groups[i].Offset {
+			t.Errorf("groups out of order at %d", groups[i].Offset)
+		}
+	}
+	if groups[len(groups)-1].Offset != 14*3600 {
+		t.Errorf("expected UTC+14:00 last, got %d", groups[len(groups)-1].Offset)
+	}
+}
+
+func TestZoneListUseCase_ShouldFilterByOffsetRegionAndDST(t *testing.T) {
+	// Given a zone list in northern summer
+	uc := NewZoneListUseCase(&MockZoneListFormatter{}).WithClock(FixedClock(time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC)))
+	observes := true
+
+	// When listing European zones an hour ahead of UTC that observe DST
+	list, err := uc.FindZoneList(ZoneListRequest{Offset: "+01:00", Region: "europe/", DST: &observes})
+
+	// Then only zones on summer time at that offset should be left, such as London
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Groups) != 1 || list.Groups[0].Offset != 3600 {
+		t.Fatalf("expected one UTC+01:00 group, got %+v", list.Groups)
+	}
+	found := false
+	for _, z := range list.Groups[0].Zones {
+		if !z.ObservesDST {
+			t.Errorf("%s does not observe DST", z.Timezone.Name)
+		}
+		found = found || z.Timezone.Name == "Europe/London"
+	}
+	if !found {
+		t.Error("expected Europe/London to be listed")
+	}
+}
+
+func TestZoneListUseCase_ShouldIncludeZonesNoCountryLists(t *testing.T) {
+	// Given a zone list use case
+	uc := NewZoneListUseCase(&MockZoneListFormatter{}).WithClock(FixedClock(time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)))
+
+	// When listing zones five hours behind UTC
+	list, err := uc.FindZoneList(ZoneListRequest{Offset: "-05:00"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Then fixed-offset zones such as Etc/GMT+5 should be listed with New York
+	names := make(map[string]bool)
+	for _, group := range list.Groups {
+		for _, z := range group.Zones {
+			names[z.Timezone.Name] = true
+		}
+	}
+	if !names["Etc/GMT+5"] || !names["America/New_York"] {
+		t.Errorf("expected Etc/GMT+5 and America/New_York, got %v", names)
+	}
+}
+
+func TestZoneListUseCase_ShouldRejectInvalidOffsets(t *testing.T) {
+	// Given a zone list use case
+	formatter := &MockZoneListFormatter{}
+	uc := NewZoneListUseCase(formatter)
+
+	// When filtering by something that is not an offset
+	_, err := uc.GetZoneList(ZoneListRequest{Offset: "+25:00"})
+
+	// Then an error should be formatted for the user
+	if err == nil || !formatter.formatErrorCalled {
+		t.Errorf("expected a formatted error, got %v", err)
+	}
+}
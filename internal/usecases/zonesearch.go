@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/loginx/alfred-timein/internal/domain"
+)
+
+// defaultZoneSearchLimit is how many zones a search suggests unless told otherwise
+const defaultZoneSearchLimit = 10
+
+// ZoneSuggestion is a zone offered for a partial or misspelled query
+type ZoneSuggestion struct {
+	*TimezoneInfo
+	Alias string // the link name the query matched, e.g. Asia/Calcutta, if not the zone itself
+}
+
+// ZoneSearch lists the zones best matching a query, best first
+type ZoneSearch struct {
+	Query string
+	Zones []*ZoneSuggestion
+}
+
+// ZoneSearchUseCase handles suggesting IANA zones for partial or misspelled names
+type ZoneSearchUseCase struct {
+	formatter ZoneSearchFormatter
+	clock     Clock
+	limit     int
+}
+
+// NewZoneSearchUseCase creates a new ZoneSearchUseCase
+func NewZoneSearchUseCase(formatter ZoneSearchFormatter) *ZoneSearchUseCase {
+	return &ZoneSearchUseCase{
+		formatter: formatter,
+		clock:     SystemClock{},
+		limit:     defaultZoneSearchLimit,
+	}
+}
+
+// WithClock makes the suggestions show the time at the clock's instant instead of now
+func (uc *ZoneSearchUseCase) WithClock(clock Clock) *ZoneSearchUseCase {
+	uc.clock = clock
+	return uc
+}
+
+// WithLimit caps how many zones are suggested; 0 or less suggests every match
+func (uc *ZoneSearchUseCase) WithLimit(limit int) *ZoneSearchUseCase {
+	uc.limit = limit
+	return uc
+}
+
+// GetZoneSuggestions formats the zones matching a query
+func (uc *ZoneSearchUseCase) GetZoneSuggestions(query string) ([]byte, error) {
+	search, err := uc.FindZones(query)
+	if err != nil {
+		output, _ := uc.formatter.FormatError(err.Error())
+		return output, err
+	}
+
+	return uc.formatter.FormatZoneSearch(search)
+}
+
+// FindZones ranks zone names, exemplar cities and links against the query
+func (uc *ZoneSearchUseCase) FindZones(query string) (*ZoneSearch, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("search text required")
+	}
+
+	now := uc.clock.Now()
+	search := &ZoneSearch{Query: query}
+	for _, match := range domain.SearchZones(query, uc.limit) {
+		tz := &domain.Timezone{Name: match.Name}
+		loc, err := tz.Location()
+		if err != nil {
+			continue
+		}
+		search.Zones = append(search.Zones, &ZoneSuggestion{
+			TimezoneInfo: newTimezoneInfo(tz, now.In(loc)),
+			Alias:        match.Alias,
+		})
+	}
+	if len(search.Zones) == 0 {
+		return nil, fmt.Errorf("no timezone matches %q", query)
+	}
+	return search, nil
+}
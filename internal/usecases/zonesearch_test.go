@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// MockZoneSearchFormatter records zone suggestions for testing
+type MockZoneSearchFormatter struct {
+	MockFormatter
+	search *ZoneSearch
+}
+
+func (m *MockZoneSearchFormatter) FormatZoneSearch(search *ZoneSearch) ([]byte, error) {
+	m.search = search
+	return []byte("mock zone search"), nil
+}
+
+func TestZoneSearchUseCase_ShouldSuggestZonesWithTheirLocalTime(t *testing.T) {
+	// Given a zone search at a fixed instant
+	at := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+	formatter := &MockZoneSearchFormatter{}
+	uc := NewZoneSearchUseCase(formatter).WithClock(FixedClock(at)).WithLimit(3)
+
+	// When searching for a partial zone name
+	_, err := uc.GetZoneSuggestions("los_ang")
+
+	// Then Los Angeles should be suggested first, at that instant in its own zone
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	zones := formatter.search.Zones
+	if len(zones) == 0 || len(zones) > 3 {
+		t.Fatalf("expected 1 to 3 suggestions, got %d", len(zones))
+	}
+	if zones[0].Timezone.Name != "America/Los_Angeles" || zones[0].CurrentTime.Hour() != 4 {
+		t.Errorf("expected America/Los_Angeles at 04:00, got %s at %s", zones[0].Timezone.Name, zones[0].CurrentTime)
+	}
+}
+
+func TestZoneSearchUseCase_ShouldRecordTheAliasThatMatched(t *testing.T) {
+	// Given a zone search
+	uc := NewZoneSearchUseCase(&MockZoneSearchFormatter{})
+
+	// When searching for a deprecated zone name
+	search, err := uc.FindZones("Asia/Calcutta")
+
+	// Then the current zone should be suggested under its alias
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if search.Zones[0].Timezone.Name != "Asia/Kolkata" || search.Zones[0].Alias != "Asia/Calcutta" {
+		t.Errorf("expected Asia/Kolkata via Asia/Calcutta, got %s via %q", search.Zones[0].Timezone.Name, search.Zones[0].Alias)
+	}
+}
+
+func TestZoneSearchUseCase_ShouldReportQueriesWithoutMatches(t *testing.T) {
+	// Given a zone search
+	formatter := &MockZoneSearchFormatter{}
+	uc := NewZoneSearchUseCase(formatter)
+
+	// When nothing resembles the query
+	_, err := uc.GetZoneSuggestions("zzqqx")
+
+	// Then an error should be formatted for the user
+	if err == nil || !formatter.formatErrorCalled {
+		t.Errorf("expected a formatted error, got %v", err)
+	}
+}
+
+func TestTimeinUseCase_ShouldSuggestZonesForMisspelledNames(t *testing.T) {
+	// Given a timein use case whose formatter can list suggestions
+	formatter := &MockZoneSearchFormatter{}
+	uc := NewTimeinUseCase(formatter)
+
+	// When asking for a partial zone name
+	output, err := uc.GetTimezoneInfo("kolk")
+
+	// Then the matching zones should be offered along with the error
+	var suggested *SuggestedZonesError
+	if !errors.As(err, &suggested) {
+		t.Fatalf("expected a SuggestedZonesError, got %v", err)
+	}
+	if string(output) != "mock zone search" || formatter.search.Zones[0].Timezone.Name != "Asia/Kolkata" {
+		t.Errorf("expected Asia/Kolkata to be suggested, got %q", output)
+	}
+}
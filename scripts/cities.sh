@@ -0,0 +1,78 @@
+#!/bin/bash
+# Regenerates internal/adapters/geocoder/cities.tsv, the offline gazetteer, from GeoNames'
+# cities15000 extract and the names of the regions cities lie in, from admin1CodesASCII.txt
+# (https://download.geonames.org/export/dump/, CC BY 4.0).
+#
+# Usage:
+#
+#   scripts/cities.sh                                           download both from GeoNames
+#   scripts/cities.sh cities15000.zip [admin1CodesASCII.txt]    use local copies, zipped or not
+#
+# Every city of CITIES_MIN_POPULATION people or more is kept, 15000 by default, along with
+# every capital (feature code PPLC) whatever its size, which is how GeoNames itself cuts
+# cities15000. Raising the cutoff trades small towns for a smaller binary.
+
+set -euo pipefail
+
+if [ $# -gt 2 ]; then
+	echo "usage: $0 [cities15000.zip|cities15000.txt [admin1CodesASCII.txt]]" >&2
+	exit 2
+fi
+
+min=${CITIES_MIN_POPULATION:-15000}
+root=$(cd "$(dirname "$0")/.." && pwd)
+work=$(mktemp -d)
+trap 'rm -rf "$work"' EXIT
+
+src=${1:-}
+if [ -z "$src" ]; then
+	src=$work/cities15000.zip
+	curl -sSfL -o "$src" https://download.geonames.org/export/dump/cities15000.zip
+fi
+case "$src" in
+*.zip) unzip -p "$src" cities15000.txt > "$work/cities15000.txt" ;;
+*) cp "$src" "$work/cities15000.txt" ;;
+esac
+
+admin1=${2:-}
+if [ -z "$admin1" ]; then
+	admin1=$work/admin1CodesASCII.txt
+	curl -sSfL -o "$admin1" https://download.geonames.org/export/dump/admin1CodesASCII.txt
+fi
+
+# name, ASCII name, alternate names without links, latitude, longitude, country, region,
+# population; regions are looked up by "country.admin1 code", e.g. US.OR for Oregon
+LC_ALL=C awk -F '\t' -v OFS='\t' -v min="$min" '
+	NR == FNR {
+		regions[$1] = $2
+		next
+	}
+	$15 >= min || $8 == "PPLC" {
+		n = split($4, alternates, ",")
+		names = ""
+		for (i = 1; i <= n; i++) {
+			if (alternates[i] ~ /^https?:/) {
+				continue
+			}
+			names = names (names == "" ? "" : ",") alternates[i]
+		}
+		print $2, $3, names, $5, $6, $9, regions[$9 "." $11], $15
+	}' "$admin1" "$work/cities15000.txt" | LC_ALL=C sort -t "$(printf '\t')" -k8,8nr -k1,1 > "$work/rows"
+
+count=$(wc -l < "$work/rows" | tr -d ' ')
+{
+	cat <<EOF
+# Cities for the offline gazetteer, one per line in a trimmed GeoNames layout:
+# name, ASCII name, comma-separated alternate names, latitude, longitude,
+# ISO 3166 country code, region and population, separated by tabs, most populous first.
+#
+# Generated by scripts/cities.sh from GeoNames' cities15000.txt and admin1CodesASCII.txt
+# (https://download.geonames.org/export/dump/, CC BY 4.0): $count cities of
+# $min or more people, and capitals of any size.
+#
+# Minimum population: $min
+EOF
+	cat "$work/rows"
+} > "$root/internal/adapters/geocoder/cities.tsv"
+
+echo "Embedded $count cities"
@@ -0,0 +1,90 @@
+#!/bin/bash
+# Regenerates every piece of embedded tzdb data from one tzdb release, so the zone rules
+# timein loads and the tables it names, places and canonicalizes zones with never drift
+# apart:
+#
+#   internal/adapters/tzdata/zoneinfo.zip  compiled zone rules
+#   internal/adapters/tzdata/VERSION       the release, e.g. 2026c
+#   internal/domain/tzdata/zone1970.tab    zone coordinates and countries
+#   internal/domain/tzdata/zone.tab
+#   internal/domain/tzdata/iso3166.tab     country names
+#   internal/domain/tzdata/backward        links from old zone names to current ones
+#   internal/domain/tzdata/zones           every zone, for listing them
+#
+# Usage:
+#
+#   scripts/tzdata.sh 2026c                 download the release from IANA
+#   scripts/tzdata.sh /usr/share/zoneinfo   use a local tzdb holding tzdata.zi and the tables
+#
+# Releases are compiled the way Go's lib/time/update.bash does, with backzone data for
+# zones in zone.tab, so links that backzone turns back into zones of their own, such as
+# Europe/Vaduz, stay zones.
+
+set -euo pipefail
+
+if [ $# -ne 1 ]; then
+	echo "usage: $0 VERSION|TZDIR" >&2
+	exit 2
+fi
+
+root=$(cd "$(dirname "$0")/.." && pwd)
+work=$(mktemp -d)
+trap 'rm -rf "$work"' EXIT
+
+if [ -d "$1" ]; then
+	src=$(cd "$1" && pwd)
+else
+	src=$work/src
+	mkdir "$src"
+	curl -sSfL -o "$work/tzdata.tar.gz" "https://data.iana.org/time-zones/releases/tzdata$1.tar.gz"
+	tar xzf "$work/tzdata.tar.gz" -C "$src"
+	make -s -C "$src" AWK=awk PACKRATDATA=backzone PACKRATLIST=zone.tab tzdata.zi
+fi
+
+version=$(sed -n '1s/^# version //p' "$src/tzdata.zi")
+if [ -z "$version" ]; then
+	echo "$src/tzdata.zi has no version header" >&2
+	exit 1
+fi
+
+# Zone rules, zipped as package time expects
+mkdir "$work/zoneinfo"
+zic -b slim -d "$work/zoneinfo" "$src/tzdata.zi"
+(cd "$work/zoneinfo" && go run "$(go env GOROOT)/lib/time/mkzip.go" "$root/internal/adapters/tzdata/zoneinfo.zip")
+echo "$version" > "$root/internal/adapters/tzdata/VERSION"
+
+# Tables
+for tab in zone1970.tab zone.tab iso3166.tab; do
+	cp "$src/$tab" "$root/internal/domain/tzdata/$tab"
+done
+
+# Links, except GMT and UTC, which timein keeps as they are rather than showing Etc/ names
+{
+	cat <<EOF
+# tzdb links from old or alternative zone names to current ones
+#
+# This file is in the public domain.
+#
+# Extracted from tzdb's "backward" file as compiled into tzdata.zi ($version) by
+# scripts/tzdata.sh. Links that tzdb's backzone data turns back into zones of their own,
+# such as Europe/Vaduz, are absent; timein keeps those names as they are.
+#
+# Link	TARGET	LINK-NAME
+EOF
+	awk '$1 == "L" && $3 != "GMT" && $3 != "UTC" { print "Link\t" $2 "\t" $3 }' "$src/tzdata.zi"
+} > "$root/internal/domain/tzdata/backward"
+
+# Zones, including those zone.tab leaves out such as Etc/GMT+5
+{
+	cat <<EOF
+# tzdb zones, excluding links and the Factory placeholder
+#
+# This file is in the public domain.
+#
+# Extracted from the zones compiled into tzdata.zi ($version) by scripts/tzdata.sh.
+#
+EOF
+	awk '$1 == "Z" && $2 != "Factory" { print $2 }' "$src/tzdata.zi" | LC_ALL=C sort
+} > "$root/internal/domain/tzdata/zones"
+
+echo "Embedded tzdata $version"